@@ -0,0 +1,469 @@
+package series
+
+import (
+	series2 "github.com/SHIMA0111/gleam/gleam/series"
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"testing"
+)
+
+// generateNullableInt64Data creates a Series with n int64 elements where every 10th element is null
+func generateNullableInt64Data(n int) *series2.Series {
+	mem := memory.NewGoAllocator()
+	builder := array.NewInt64Builder(mem)
+
+	// Pre-allocate space for better performance
+	builder.Reserve(n)
+
+	for i := 0; i < n; i++ {
+		if i%10 == 0 {
+			builder.AppendNull()
+			continue
+		}
+		builder.Append(int64(i % 100)) // Use modulo to avoid overflow and keep values small
+	}
+
+	arr := builder.NewArray()
+	// Release the builder after creating the array
+	builder.Release()
+
+	// Don't release the array here, the Series will own it
+	return series2.NewSeries("benchmark_nullable_int64", arr)
+}
+
+// generateNullableFloat64Data creates a Series with n float64 elements where every 10th element is null
+func generateNullableFloat64Data(n int) *series2.Series {
+	mem := memory.NewGoAllocator()
+	builder := array.NewFloat64Builder(mem)
+
+	// Pre-allocate space for better performance
+	builder.Reserve(n)
+
+	for i := 0; i < n; i++ {
+		if i%10 == 0 {
+			builder.AppendNull()
+			continue
+		}
+		builder.Append(float64(i % 100)) // Use modulo to avoid overflow and keep values small
+	}
+
+	arr := builder.NewArray()
+	// Release the builder after creating the array
+	builder.Release()
+
+	// Don't release the array here, the Series will own it
+	return series2.NewSeries("benchmark_nullable_float64", arr)
+}
+
+// Nullable Sum benchmarks
+func BenchmarkSum_Nullable_Medium_Int64(b *testing.B) {
+	// Generate a medium dataset (1,000,000 elements)
+	data := generateNullableInt64Data(1_000_000)
+	defer data.Release()
+
+	// Set the number of bytes processed for throughput calculation
+	b.SetBytes(int64(1_000_000 * arrow.Int64SizeBytes))
+
+	// Report allocations to compare them with the non-null benchmarks
+	b.ReportAllocs()
+
+	// Reset the timer to exclude setup time
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		result, err := data.Sum()
+		if err != nil {
+			b.Fatal(err)
+		}
+		result.Release()
+	}
+}
+
+func BenchmarkSum_Nullable_Medium_Float64(b *testing.B) {
+	// Generate a medium dataset (1,000,000 elements)
+	data := generateNullableFloat64Data(1_000_000)
+	defer data.Release()
+
+	// Set the number of bytes processed for throughput calculation
+	b.SetBytes(int64(1_000_000 * arrow.Float64SizeBytes))
+
+	// Report allocations to compare them with the non-null benchmarks
+	b.ReportAllocs()
+
+	// Reset the timer to exclude setup time
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		result, err := data.Sum()
+		if err != nil {
+			b.Fatal(err)
+		}
+		result.Release()
+	}
+}
+
+func BenchmarkSum_Nullable_Large_Int64(b *testing.B) {
+	// Skip this benchmark in short mode
+	if testing.Short() {
+		b.Skip("skipping large benchmark in short mode")
+	}
+
+	// Generate a large dataset (100,000,000 elements)
+	data := generateNullableInt64Data(100_000_000)
+	defer data.Release()
+
+	// Set the number of bytes processed for throughput calculation
+	b.SetBytes(int64(100_000_000 * arrow.Int64SizeBytes))
+
+	// Report allocations to compare them with the non-null benchmarks
+	b.ReportAllocs()
+
+	// Reset the timer to exclude setup time
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		result, err := data.Sum()
+		if err != nil {
+			b.Fatal(err)
+		}
+		result.Release()
+	}
+}
+
+func BenchmarkSum_Nullable_Large_Float64(b *testing.B) {
+	// Skip this benchmark in short mode
+	if testing.Short() {
+		b.Skip("skipping large benchmark in short mode")
+	}
+
+	// Generate a large dataset (100,000,000 elements)
+	data := generateNullableFloat64Data(100_000_000)
+	defer data.Release()
+
+	// Set the number of bytes processed for throughput calculation
+	b.SetBytes(int64(100_000_000 * arrow.Float64SizeBytes))
+
+	// Report allocations to compare them with the non-null benchmarks
+	b.ReportAllocs()
+
+	// Reset the timer to exclude setup time
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		result, err := data.Sum()
+		if err != nil {
+			b.Fatal(err)
+		}
+		result.Release()
+	}
+}
+
+// Nullable Mean benchmarks
+func BenchmarkMean_Nullable_Medium_Int64(b *testing.B) {
+	// Generate a medium dataset (1,000,000 elements)
+	data := generateNullableInt64Data(1_000_000)
+	defer data.Release()
+
+	// Set the number of bytes processed for throughput calculation
+	b.SetBytes(int64(1_000_000 * arrow.Int64SizeBytes))
+
+	// Report allocations to compare them with the non-null benchmarks
+	b.ReportAllocs()
+
+	// Reset the timer to exclude setup time
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		result, err := data.Mean()
+		if err != nil {
+			b.Fatal(err)
+		}
+		result.Release()
+	}
+}
+
+func BenchmarkMean_Nullable_Medium_Float64(b *testing.B) {
+	// Generate a medium dataset (1,000,000 elements)
+	data := generateNullableFloat64Data(1_000_000)
+	defer data.Release()
+
+	// Set the number of bytes processed for throughput calculation
+	b.SetBytes(int64(1_000_000 * arrow.Float64SizeBytes))
+
+	// Report allocations to compare them with the non-null benchmarks
+	b.ReportAllocs()
+
+	// Reset the timer to exclude setup time
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		result, err := data.Mean()
+		if err != nil {
+			b.Fatal(err)
+		}
+		result.Release()
+	}
+}
+
+func BenchmarkMean_Nullable_Large_Int64(b *testing.B) {
+	// Skip this benchmark in short mode
+	if testing.Short() {
+		b.Skip("skipping large benchmark in short mode")
+	}
+
+	// Generate a large dataset (100,000,000 elements)
+	data := generateNullableInt64Data(100_000_000)
+	defer data.Release()
+
+	// Set the number of bytes processed for throughput calculation
+	b.SetBytes(int64(100_000_000 * arrow.Int64SizeBytes))
+
+	// Report allocations to compare them with the non-null benchmarks
+	b.ReportAllocs()
+
+	// Reset the timer to exclude setup time
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		result, err := data.Mean()
+		if err != nil {
+			b.Fatal(err)
+		}
+		result.Release()
+	}
+}
+
+func BenchmarkMean_Nullable_Large_Float64(b *testing.B) {
+	// Skip this benchmark in short mode
+	if testing.Short() {
+		b.Skip("skipping large benchmark in short mode")
+	}
+
+	// Generate a large dataset (100,000,000 elements)
+	data := generateNullableFloat64Data(100_000_000)
+	defer data.Release()
+
+	// Set the number of bytes processed for throughput calculation
+	b.SetBytes(int64(100_000_000 * arrow.Float64SizeBytes))
+
+	// Report allocations to compare them with the non-null benchmarks
+	b.ReportAllocs()
+
+	// Reset the timer to exclude setup time
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		result, err := data.Mean()
+		if err != nil {
+			b.Fatal(err)
+		}
+		result.Release()
+	}
+}
+
+// Nullable Max benchmarks
+func BenchmarkMax_Nullable_Medium_Int64(b *testing.B) {
+	// Generate a medium dataset (1,000,000 elements)
+	data := generateNullableInt64Data(1_000_000)
+	defer data.Release()
+
+	// Set the number of bytes processed for throughput calculation
+	b.SetBytes(int64(1_000_000 * arrow.Int64SizeBytes))
+
+	// Report allocations to compare them with the non-null benchmarks
+	b.ReportAllocs()
+
+	// Reset the timer to exclude setup time
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		result, err := data.Max()
+		if err != nil {
+			b.Fatal(err)
+		}
+		result.Release()
+	}
+}
+
+func BenchmarkMax_Nullable_Medium_Float64(b *testing.B) {
+	// Generate a medium dataset (1,000,000 elements)
+	data := generateNullableFloat64Data(1_000_000)
+	defer data.Release()
+
+	// Set the number of bytes processed for throughput calculation
+	b.SetBytes(int64(1_000_000 * arrow.Float64SizeBytes))
+
+	// Report allocations to compare them with the non-null benchmarks
+	b.ReportAllocs()
+
+	// Reset the timer to exclude setup time
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		result, err := data.Max()
+		if err != nil {
+			b.Fatal(err)
+		}
+		result.Release()
+	}
+}
+
+func BenchmarkMax_Nullable_Large_Int64(b *testing.B) {
+	// Skip this benchmark in short mode
+	if testing.Short() {
+		b.Skip("skipping large benchmark in short mode")
+	}
+
+	// Generate a large dataset (100,000,000 elements)
+	data := generateNullableInt64Data(100_000_000)
+	defer data.Release()
+
+	// Set the number of bytes processed for throughput calculation
+	b.SetBytes(int64(100_000_000 * arrow.Int64SizeBytes))
+
+	// Report allocations to compare them with the non-null benchmarks
+	b.ReportAllocs()
+
+	// Reset the timer to exclude setup time
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		result, err := data.Max()
+		if err != nil {
+			b.Fatal(err)
+		}
+		result.Release()
+	}
+}
+
+func BenchmarkMax_Nullable_Large_Float64(b *testing.B) {
+	// Skip this benchmark in short mode
+	if testing.Short() {
+		b.Skip("skipping large benchmark in short mode")
+	}
+
+	// Generate a large dataset (100,000,000 elements)
+	data := generateNullableFloat64Data(100_000_000)
+	defer data.Release()
+
+	// Set the number of bytes processed for throughput calculation
+	b.SetBytes(int64(100_000_000 * arrow.Float64SizeBytes))
+
+	// Report allocations to compare them with the non-null benchmarks
+	b.ReportAllocs()
+
+	// Reset the timer to exclude setup time
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		result, err := data.Max()
+		if err != nil {
+			b.Fatal(err)
+		}
+		result.Release()
+	}
+}
+
+// Nullable Min benchmarks
+func BenchmarkMin_Nullable_Medium_Int64(b *testing.B) {
+	// Generate a medium dataset (1,000,000 elements)
+	data := generateNullableInt64Data(1_000_000)
+	defer data.Release()
+
+	// Set the number of bytes processed for throughput calculation
+	b.SetBytes(int64(1_000_000 * arrow.Int64SizeBytes))
+
+	// Report allocations to compare them with the non-null benchmarks
+	b.ReportAllocs()
+
+	// Reset the timer to exclude setup time
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		result, err := data.Min()
+		if err != nil {
+			b.Fatal(err)
+		}
+		result.Release()
+	}
+}
+
+func BenchmarkMin_Nullable_Medium_Float64(b *testing.B) {
+	// Generate a medium dataset (1,000,000 elements)
+	data := generateNullableFloat64Data(1_000_000)
+	defer data.Release()
+
+	// Set the number of bytes processed for throughput calculation
+	b.SetBytes(int64(1_000_000 * arrow.Float64SizeBytes))
+
+	// Report allocations to compare them with the non-null benchmarks
+	b.ReportAllocs()
+
+	// Reset the timer to exclude setup time
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		result, err := data.Min()
+		if err != nil {
+			b.Fatal(err)
+		}
+		result.Release()
+	}
+}
+
+func BenchmarkMin_Nullable_Large_Int64(b *testing.B) {
+	// Skip this benchmark in short mode
+	if testing.Short() {
+		b.Skip("skipping large benchmark in short mode")
+	}
+
+	// Generate a large dataset (100,000,000 elements)
+	data := generateNullableInt64Data(100_000_000)
+	defer data.Release()
+
+	// Set the number of bytes processed for throughput calculation
+	b.SetBytes(int64(100_000_000 * arrow.Int64SizeBytes))
+
+	// Report allocations to compare them with the non-null benchmarks
+	b.ReportAllocs()
+
+	// Reset the timer to exclude setup time
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		result, err := data.Min()
+		if err != nil {
+			b.Fatal(err)
+		}
+		result.Release()
+	}
+}
+
+func BenchmarkMin_Nullable_Large_Float64(b *testing.B) {
+	// Skip this benchmark in short mode
+	if testing.Short() {
+		b.Skip("skipping large benchmark in short mode")
+	}
+
+	// Generate a large dataset (100,000,000 elements)
+	data := generateNullableFloat64Data(100_000_000)
+	defer data.Release()
+
+	// Set the number of bytes processed for throughput calculation
+	b.SetBytes(int64(100_000_000 * arrow.Float64SizeBytes))
+
+	// Report allocations to compare them with the non-null benchmarks
+	b.ReportAllocs()
+
+	// Reset the timer to exclude setup time
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		result, err := data.Min()
+		if err != nil {
+			b.Fatal(err)
+		}
+		result.Release()
+	}
+}
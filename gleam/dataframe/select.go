@@ -2,34 +2,31 @@ package dataframe
 
 import (
 	"fmt"
+
 	"github.com/apache/arrow-go/v18/arrow"
-	"github.com/apache/arrow-go/v18/arrow/array"
-	"golang.org/x/exp/maps"
-	"slices"
 )
 
+// Select returns a new DataFrame with the given columns in the given order. The columns are shared, not copied.
 func (df *DataFrame) Select(cols []string) (*DataFrame, error) {
 	if len(cols) == 0 {
 		return nil, fmt.Errorf("select columns must be non-empty")
 	}
 
-	idxes := make([]int32, len(cols))
+	idxes := make([]int, len(cols))
 	for i, name := range cols {
-		if !slices.Contains(maps.Keys(df.colMap), name) {
+		fieldIdxes := df.schema.FieldIndices(name)
+		if len(fieldIdxes) == 0 {
 			return nil, fmt.Errorf("column %s not found", name)
 		}
-		idxes[i] = df.colMap[name]
+		idxes[i] = fieldIdxes[0]
 	}
 
-	fields := make([]arrow.Field, len(idxes))
 	columns := make([]arrow.Array, len(idxes))
 	for i, idx := range idxes {
-		fields[i] = df.record.Schema().Field(int(idx))
-		columns[i] = df.record.Column(int(idx))
+		// The new DataFrame releases its columns, so each shared column is retained
+		columns[i] = df.columns[idx]
+		columns[i].Retain()
 	}
 
-	schema := arrow.NewSchema(fields, nil)
-	newRecord := array.NewRecord(schema, columns, int64(columns[0].Len()))
-
-	return NewDataFrame(df.mem, newRecord), nil
+	return NewDataFrame(columns, cols)
 }
@@ -4,23 +4,22 @@ import (
 	"testing"
 
 	"github.com/apache/arrow-go/v18/arrow"
-	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/arrow/array"
 )
 
 func TestDataFrame_Select(t *testing.T) {
-	// Setup memory allocator
-	mem := memory.NewGoAllocator()
+	col1 := newColumn(t, []int64{1, 2, 3, 4, 5})
+	defer col1.Release()
+	col2 := newColumn(t, []float64{1.1, 2.2, 3.3, 4.4, 5.5})
+	defer col2.Release()
+	col3 := newColumn(t, []string{"a", "b", "c", "d", "e"})
+	defer col3.Release()
+
+	names := []string{"col1", "col2", "col3"}
 
 	t.Run("select single column", func(t *testing.T) {
 		// Create a test DataFrame with multiple columns
-		df, err := NewDataFrameFromMapWithMemory(mem, map[string]interface{}{
-			"col1": []int64{1, 2, 3, 4, 5},
-			"col2": []float64{1.1, 2.2, 3.3, 4.4, 5.5},
-			"col3": []string{"a", "b", "c", "d", "e"},
-		})
-		if err != nil {
-			t.Fatalf("failed to create DataFrame: %v", err)
-		}
+		df := newTestDataFrame(t, names, col1, col2, col3)
 		defer df.Release()
 
 		// Select a single column
@@ -31,17 +30,12 @@ func TestDataFrame_Select(t *testing.T) {
 		defer result.Release()
 
 		// Check result
-		if len(result.colMap) != 1 {
-			t.Errorf("expected 1 column, got %d", len(result.colMap))
-		}
-
-		// Check if the column exists in the result
-		if _, ok := result.colMap["col2"]; !ok {
-			t.Errorf("expected column 'col2' to exist in result")
+		if result.numCols != 1 {
+			t.Errorf("expected 1 column, got %d", result.numCols)
 		}
 
-		// Check if the column has the correct type
-		field := result.record.Schema().Field(0)
+		// Check if the column has the correct name and type
+		field := result.schema.Field(0)
 		if field.Name != "col2" {
 			t.Errorf("expected column name 'col2', got '%s'", field.Name)
 		}
@@ -50,21 +44,14 @@ func TestDataFrame_Select(t *testing.T) {
 		}
 
 		// Check if the column has the correct length
-		if result.record.Column(0).Len() != 5 {
-			t.Errorf("expected column length 5, got %d", result.record.Column(0).Len())
+		if result.columns[0].Len() != 5 {
+			t.Errorf("expected column length 5, got %d", result.columns[0].Len())
 		}
 	})
 
 	t.Run("select multiple columns", func(t *testing.T) {
 		// Create a test DataFrame with multiple columns
-		df, err := NewDataFrameFromMapWithMemory(mem, map[string]interface{}{
-			"col1": []int64{1, 2, 3, 4, 5},
-			"col2": []float64{1.1, 2.2, 3.3, 4.4, 5.5},
-			"col3": []string{"a", "b", "c", "d", "e"},
-		})
-		if err != nil {
-			t.Fatalf("failed to create DataFrame: %v", err)
-		}
+		df := newTestDataFrame(t, names, col1, col2, col3)
 		defer df.Release()
 
 		// Select multiple columns
@@ -75,20 +62,12 @@ func TestDataFrame_Select(t *testing.T) {
 		defer result.Release()
 
 		// Check result
-		if len(result.colMap) != 2 {
-			t.Errorf("expected 2 columns, got %d", len(result.colMap))
+		if result.numCols != 2 {
+			t.Errorf("expected 2 columns, got %d", result.numCols)
 		}
 
-		// Check if the columns exist in the result
-		if _, ok := result.colMap["col1"]; !ok {
-			t.Errorf("expected column 'col1' to exist in result")
-		}
-		if _, ok := result.colMap["col3"]; !ok {
-			t.Errorf("expected column 'col3' to exist in result")
-		}
-
-		// Check if the columns have the correct types
-		field1 := result.record.Schema().Field(0)
+		// Check if the columns have the correct names and types
+		field1 := result.schema.Field(0)
 		if field1.Name != "col1" {
 			t.Errorf("expected column name 'col1', got '%s'", field1.Name)
 		}
@@ -96,7 +75,7 @@ func TestDataFrame_Select(t *testing.T) {
 			t.Errorf("expected column type Int64, got %s", field1.Type)
 		}
 
-		field2 := result.record.Schema().Field(1)
+		field2 := result.schema.Field(1)
 		if field2.Name != "col3" {
 			t.Errorf("expected column name 'col3', got '%s'", field2.Name)
 		}
@@ -105,44 +84,32 @@ func TestDataFrame_Select(t *testing.T) {
 		}
 
 		// Check if the columns have the correct length
-		if result.record.Column(0).Len() != 5 {
-			t.Errorf("expected column length 5, got %d", result.record.Column(0).Len())
-		}
-		if result.record.Column(1).Len() != 5 {
-			t.Errorf("expected column length 5, got %d", result.record.Column(1).Len())
+		for i, col := range result.columns {
+			if col.Len() != 5 {
+				t.Errorf("expected column %d length 5, got %d", i, col.Len())
+			}
 		}
 	})
 
 	t.Run("select with empty column list", func(t *testing.T) {
 		// Create a test DataFrame
-		df, err := NewDataFrameFromMapWithMemory(mem, map[string]interface{}{
-			"col1": []int64{1, 2, 3, 4, 5},
-		})
-		if err != nil {
-			t.Fatalf("failed to create DataFrame: %v", err)
-		}
+		df := newTestDataFrame(t, []string{"col1"}, col1)
 		defer df.Release()
 
 		// Try to select with empty column list
-		_, err = df.Select([]string{})
-		if err == nil {
+		if _, err := df.Select([]string{}); err == nil {
 			t.Errorf("expected error for empty column list, got nil")
 		}
 	})
 
 	t.Run("select non-existent column", func(t *testing.T) {
 		// Create a test DataFrame
-		df, err := NewDataFrameFromMapWithMemory(mem, map[string]interface{}{
-			"col1": []int64{1, 2, 3, 4, 5},
-		})
-		if err != nil {
-			t.Fatalf("failed to create DataFrame: %v", err)
-		}
+		df := newTestDataFrame(t, []string{"col1"}, col1)
 		defer df.Release()
 
 		// Try to select a non-existent column
 		// This should return an error
-		_, err = df.Select([]string{"non_existent"})
+		_, err := df.Select([]string{"non_existent"})
 		if err == nil {
 			t.Errorf("expected error for non-existent column, got nil")
 		} else if err.Error() != "column non_existent not found" {
@@ -152,14 +119,7 @@ func TestDataFrame_Select(t *testing.T) {
 
 	t.Run("select all columns in different order", func(t *testing.T) {
 		// Create a test DataFrame with multiple columns
-		df, err := NewDataFrameFromMapWithMemory(mem, map[string]interface{}{
-			"col1": []int64{1, 2, 3, 4, 5},
-			"col2": []float64{1.1, 2.2, 3.3, 4.4, 5.5},
-			"col3": []string{"a", "b", "c", "d", "e"},
-		})
-		if err != nil {
-			t.Fatalf("failed to create DataFrame: %v", err)
-		}
+		df := newTestDataFrame(t, names, col1, col2, col3)
 		defer df.Release()
 
 		// Select all columns in a different order
@@ -170,35 +130,33 @@ func TestDataFrame_Select(t *testing.T) {
 		defer result.Release()
 
 		// Check result
-		if len(result.colMap) != 3 {
-			t.Errorf("expected 3 columns, got %d", len(result.colMap))
-		}
-
-		// Check if the columns exist in the result
-		if _, ok := result.colMap["col1"]; !ok {
-			t.Errorf("expected column 'col1' to exist in result")
-		}
-		if _, ok := result.colMap["col2"]; !ok {
-			t.Errorf("expected column 'col2' to exist in result")
-		}
-		if _, ok := result.colMap["col3"]; !ok {
-			t.Errorf("expected column 'col3' to exist in result")
+		if result.numCols != 3 {
+			t.Errorf("expected 3 columns, got %d", result.numCols)
 		}
 
 		// Check if the columns are in the correct order
-		field1 := result.record.Schema().Field(0)
-		if field1.Name != "col3" {
-			t.Errorf("expected first column name 'col3', got '%s'", field1.Name)
+		for i, name := range []string{"col3", "col1", "col2"} {
+			if field := result.schema.Field(i); field.Name != name {
+				t.Errorf("expected column %d name '%s', got '%s'", i, name, field.Name)
+			}
 		}
+	})
+
+	t.Run("selected columns outlive the source", func(t *testing.T) {
+		df := newTestDataFrame(t, []string{"col1", "col2"}, col1, col2)
 
-		field2 := result.record.Schema().Field(1)
-		if field2.Name != "col1" {
-			t.Errorf("expected second column name 'col1', got '%s'", field2.Name)
+		result, err := df.Select([]string{"col2"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
 		}
+		defer result.Release()
+
+		// The columns are shared, so releasing the source leaves the selection intact
+		df.Release()
 
-		field3 := result.record.Schema().Field(2)
-		if field3.Name != "col2" {
-			t.Errorf("expected third column name 'col2', got '%s'", field3.Name)
+		values := columnArray(t, result, "col2").(*array.Float64)
+		if values.Len() != 5 || values.Value(0) != 1.1 || values.Value(4) != 5.5 {
+			t.Errorf("expected [1.1 2.2 3.3 4.4 5.5], got %s", values)
 		}
 	})
 }
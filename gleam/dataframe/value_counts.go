@@ -0,0 +1,37 @@
+package dataframe
+
+import (
+	"context"
+
+	"github.com/apache/arrow-go/v18/arrow"
+
+	"github.com/SHIMA0111/gleam/gleam/series"
+)
+
+// ValueCounts returns the distinct values of the Series and how many times each appears as a two-column
+// DataFrame: the values, named after the Series, and the counts, named "count", or "proportion" if normalize
+// is true. See series.Series.ValueCounts for the order of the rows.
+func ValueCounts(s *series.Series, sort, normalize bool) (*DataFrame, error) {
+	return ValueCountsCtx(context.Background(), s, sort, normalize)
+}
+
+// ValueCountsCtx is ValueCounts with a caller-provided context.
+func ValueCountsCtx(ctx context.Context, s *series.Series, sort, normalize bool) (*DataFrame, error) {
+	values, counts, err := s.ValueCountsCtx(ctx, sort, normalize)
+	if err != nil {
+		return nil, err
+	}
+	defer values.Release()
+	defer counts.Release()
+
+	// Both Series have a single chunk, which the DataFrame takes a reference to
+	columns := make([]arrow.Array, 2)
+	for i, column := range []*series.Series{values, counts} {
+		chunked := column.Chunked()
+		columns[i] = chunked.Chunk(0)
+		columns[i].Retain()
+		chunked.Release()
+	}
+
+	return NewDataFrame(columns, []string{values.Name(), counts.Name()})
+}
@@ -0,0 +1,67 @@
+package dataframe
+
+import (
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+
+	"github.com/SHIMA0111/gleam/gleam/series"
+)
+
+func TestValueCounts(t *testing.T) {
+	s := series.FromSlice("country", []string{"JP", "US", "JP", "FR", "JP", "US"})
+	defer s.Release()
+
+	t.Run("sorted counts", func(t *testing.T) {
+		df, err := ValueCounts(s, true, false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer df.Release()
+
+		if df.numCols != 2 || df.numRows != 3 {
+			t.Fatalf("expected 2 columns and 3 rows, got %d and %d", df.numCols, df.numRows)
+		}
+		if df.schema.Field(0).Name != "country" || df.schema.Field(1).Name != "count" {
+			t.Errorf("expected columns country and count, got %s and %s", df.schema.Field(0).Name, df.schema.Field(1).Name)
+		}
+		if !arrow.TypeEqual(df.schema.Field(1).Type, arrow.PrimitiveTypes.Int64) {
+			t.Errorf("expected Int64 counts, got %s", df.schema.Field(1).Type)
+		}
+
+		values := columnArray(t, df, "country").(*array.String)
+		counts := columnArray(t, df, "count").(*array.Int64)
+		if values.Value(0) != "JP" || values.Value(1) != "US" || values.Value(2) != "FR" ||
+			counts.Value(0) != 3 || counts.Value(1) != 2 || counts.Value(2) != 1 {
+			t.Errorf("expected [JP US FR] with counts [3 2 1], got %s and %s", values, counts)
+		}
+	})
+
+	t.Run("normalized in order of first appearance", func(t *testing.T) {
+		df, err := ValueCounts(s, false, true)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer df.Release()
+
+		if df.schema.Field(1).Name != "proportion" {
+			t.Errorf("expected the proportion column, got %s", df.schema.Field(1).Name)
+		}
+
+		values := columnArray(t, df, "country").(*array.String)
+		proportions := columnArray(t, df, "proportion").(*array.Float64)
+		if values.Value(0) != "JP" || values.Value(2) != "FR" || proportions.Value(0) != 0.5 {
+			t.Errorf("expected [JP US FR] with JP at 0.5, got %s and %s", values, proportions)
+		}
+	})
+
+	t.Run("unhashable type", func(t *testing.T) {
+		lists := series.FromListSlice("tags", [][]string{{"a"}})
+		defer lists.Release()
+
+		if _, err := ValueCounts(lists, false, false); err == nil {
+			t.Errorf("expected unsupported type error, got nil")
+		}
+	})
+}
@@ -2,10 +2,43 @@ package dataframe
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/compute"
+
 	"github.com/SHIMA0111/gleam/gleam/series"
+	"github.com/SHIMA0111/gleam/internal/compute/array"
 )
 
+// Where filters the rows of the DataFrame by the given comparison array, e.g. the result of Series.Comparison,
+// returning a new DataFrame with the rows where the comparison array is true.
 func (df *DataFrame) Where(filterArray series.ComparisonArray) (*DataFrame, error) {
-	ctx := context.Background()
+	return df.WhereCtx(context.Background(), filterArray)
+}
+
+// WhereCtx is Where with a caller-provided context.
+func (df *DataFrame) WhereCtx(ctx context.Context, filterArray series.ComparisonArray) (*DataFrame, error) {
+	if filterArray.Len() != df.numRows {
+		return nil, fmt.Errorf("filter array length is not equal to the number of rows: %d != %d", filterArray.Len(), df.numRows)
+	}
+
+	// FilterOptions: currently using the default options
+	filterOpts := compute.DefaultFilterOptions()
+
+	columns := make([]arrow.Array, df.numCols)
+	names := make([]string, df.numCols)
+	for i, column := range df.columns {
+		filtered, err := array.Filter(ctx, column, filterArray, *filterOpts)
+		if err != nil {
+			for _, col := range columns[:i] {
+				col.Release()
+			}
+			return nil, err
+		}
+		columns[i] = filtered
+		names[i] = df.schema.Field(i).Name
+	}
 
+	return NewDataFrame(columns, names)
 }
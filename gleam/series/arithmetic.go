@@ -0,0 +1,175 @@
+package series
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apache/arrow-go/v18/arrow"
+
+	"github.com/SHIMA0111/gleam/gleam/utils"
+	"github.com/SHIMA0111/gleam/internal/compute/array"
+)
+
+// Add adds other to the Series element-wise. other is a Series of the same length or a Go number, which is
+// added to every element; see makeScalar for the supported Go types. The Series must be numeric.
+// The operands are promoted to a common type, e.g. Int32 and Float64 to Float64, while an integer number takes
+// the integer type of the Series if it fits and any number takes the float type of a float Series.
+// Nulls propagate, and an integer result that overflows is handled by WithIntegerOverflow.
+// The result has the name of the Series.
+func (s *Series) Add(other interface{}, opts ...ArithmeticOption) (*Series, error) {
+	return s.AddCtx(context.Background(), other, opts...)
+}
+
+// AddCtx is Add with a caller-provided context.
+func (s *Series) AddCtx(ctx context.Context, other interface{}, opts ...ArithmeticOption) (*Series, error) {
+	return s.arithmetic(ctx, array.OpAdd, other, opts)
+}
+
+// Sub subtracts other from the Series element-wise, see Add.
+func (s *Series) Sub(other interface{}, opts ...ArithmeticOption) (*Series, error) {
+	return s.SubCtx(context.Background(), other, opts...)
+}
+
+// SubCtx is Sub with a caller-provided context.
+func (s *Series) SubCtx(ctx context.Context, other interface{}, opts ...ArithmeticOption) (*Series, error) {
+	return s.arithmetic(ctx, array.OpSub, other, opts)
+}
+
+// Mul multiplies the Series by other element-wise, see Add.
+func (s *Series) Mul(other interface{}, opts ...ArithmeticOption) (*Series, error) {
+	return s.MulCtx(context.Background(), other, opts...)
+}
+
+// MulCtx is Mul with a caller-provided context.
+func (s *Series) MulCtx(ctx context.Context, other interface{}, opts ...ArithmeticOption) (*Series, error) {
+	return s.arithmetic(ctx, array.OpMul, other, opts)
+}
+
+// Div divides the Series by other element-wise, see Add. The division is a true division, so integers are
+// divided as Float64. A division by zero follows IEEE 754 and gives an infinity, or NaN for 0 / 0.
+func (s *Series) Div(other interface{}, opts ...ArithmeticOption) (*Series, error) {
+	return s.DivCtx(context.Background(), other, opts...)
+}
+
+// DivCtx is Div with a caller-provided context.
+func (s *Series) DivCtx(ctx context.Context, other interface{}, opts ...ArithmeticOption) (*Series, error) {
+	return s.arithmetic(ctx, array.OpDiv, other, opts)
+}
+
+// FloorDiv divides the Series by other element-wise and rounds the quotient towards negative infinity, see Add.
+// An integer division by zero gives null, and a float division follows IEEE 754.
+func (s *Series) FloorDiv(other interface{}, opts ...ArithmeticOption) (*Series, error) {
+	return s.FloorDivCtx(context.Background(), other, opts...)
+}
+
+// FloorDivCtx is FloorDiv with a caller-provided context.
+func (s *Series) FloorDivCtx(ctx context.Context, other interface{}, opts ...ArithmeticOption) (*Series, error) {
+	return s.arithmetic(ctx, array.OpFloorDiv, other, opts)
+}
+
+// Mod returns the remainder of FloorDiv element-wise, which has the sign of other, see Add.
+// An integer modulo by zero gives null, and a float modulo by zero gives NaN.
+func (s *Series) Mod(other interface{}, opts ...ArithmeticOption) (*Series, error) {
+	return s.ModCtx(context.Background(), other, opts...)
+}
+
+// ModCtx is Mod with a caller-provided context.
+func (s *Series) ModCtx(ctx context.Context, other interface{}, opts ...ArithmeticOption) (*Series, error) {
+	return s.arithmetic(ctx, array.OpMod, other, opts)
+}
+
+// Pow raises the Series to the power of other element-wise, see Add. Integers are raised as Float64.
+func (s *Series) Pow(other interface{}, opts ...ArithmeticOption) (*Series, error) {
+	return s.PowCtx(context.Background(), other, opts...)
+}
+
+// PowCtx is Pow with a caller-provided context.
+func (s *Series) PowCtx(ctx context.Context, other interface{}, opts ...ArithmeticOption) (*Series, error) {
+	return s.arithmetic(ctx, array.OpPow, other, opts)
+}
+
+// Neg negates the elements of a signed integer or float Series. Nulls propagate, and negating the minimum
+// value of an integer type overflows, which is handled by WithIntegerOverflow.
+// Returns an error for an unsigned Series.
+func (s *Series) Neg(opts ...ArithmeticOption) (*Series, error) {
+	return s.NegCtx(context.Background(), opts...)
+}
+
+// NegCtx is Neg with a caller-provided context.
+func (s *Series) NegCtx(ctx context.Context, opts ...ArithmeticOption) (*Series, error) {
+	options := newArithmeticOptions(opts)
+
+	source, err := s.elementwiseSource(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	defer source.Release()
+
+	return source.mapChunks(func(chunk arrow.Array) (arrow.Array, error) {
+		return array.Negate(ctx, chunk, options.overflow, s.mem)
+	})
+}
+
+// arithmetic applies the binary operation between the Series and other, a Series or a Go number.
+func (s *Series) arithmetic(ctx context.Context, op array.ArithmeticOp, other interface{}, opts []ArithmeticOption) (*Series, error) {
+	options := newArithmeticOptions(opts)
+
+	otherSeries, ok := other.(*Series)
+	if !ok {
+		scl, err := makeScalar(other)
+		if err != nil {
+			return nil, err
+		}
+
+		source, err := s.elementwiseSource(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		defer source.Release()
+
+		return source.mapChunks(func(chunk arrow.Array) (arrow.Array, error) {
+			return array.ArithmeticScalar(ctx, op, chunk, scl, options.overflow, s.mem)
+		})
+	}
+
+	if otherSeries.Len() != s.Len() {
+		return nil, fmt.Errorf("Series %q has length %d, expected %d", otherSeries.Name(), otherSeries.Len(), s.Len())
+	}
+
+	// The elements are paired by position, so Series chunked differently are paired as single chunks
+	left, err := s.RechunkCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer left.Release()
+
+	right, err := otherSeries.RechunkCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer right.Release()
+
+	result, err := array.Arithmetic(ctx, op, left.array, right.array, options.overflow, s.mem)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.withChunks([]arrow.Array{result}), nil
+}
+
+// elementwiseSource returns the Series to apply an operation with a Go number to chunk by chunk.
+// With utils.OverflowPromoteToDecimal, a chunk that overflows changes its type, so the chunks are joined first
+// to keep one type for the result.
+func (s *Series) elementwiseSource(ctx context.Context, options arithmeticOptions) (*Series, error) {
+	if options.overflow == utils.OverflowPromoteToDecimal && s.NumChunks() > 1 {
+		return s.RechunkCtx(ctx)
+	}
+
+	chunks := make([]arrow.Array, len(s.chunks))
+	for i, chunk := range s.chunks {
+		chunk.Retain()
+		chunks[i] = chunk
+	}
+
+	return s.withChunks(chunks), nil
+}
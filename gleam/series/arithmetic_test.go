@@ -0,0 +1,260 @@
+package series
+
+import (
+	"math"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+
+	"github.com/SHIMA0111/gleam/gleam/utils"
+)
+
+func TestSeries_Arithmetic(t *testing.T) {
+	t.Run("series with series promotes", func(t *testing.T) {
+		ints, err := FromSliceWithValidity("test_int32", []int32{1, 2, 3}, []bool{true, false, true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer ints.Release()
+		floats := FromSlice("test_float64", []float64{0.5, 0.5, 0.5})
+		defer floats.Release()
+
+		result, err := ints.Add(floats)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		resultArr := result.array.(*array.Float64)
+		if result.Name() != "test_int32" || resultArr.Value(0) != 1.5 || !resultArr.IsNull(1) || resultArr.Value(2) != 3.5 {
+			t.Errorf("expected [1.5 null 3.5], got %s", result)
+		}
+
+		short := FromSlice("short", []int32{1})
+		defer short.Release()
+		if _, err := ints.Add(short); err == nil {
+			t.Errorf("expected length mismatch error, got nil")
+		}
+	})
+
+	t.Run("literals keep the series type", func(t *testing.T) {
+		s := newChunkedInt64(t, []int64{1, 2}, []int64{3})
+		defer s.Release()
+
+		ints := FromSlice("test_int8", []int8{1, 2})
+		defer ints.Release()
+
+		result, err := ints.Mul(3)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		if !arrow.TypeEqual(result.DType(), arrow.PrimitiveTypes.Int8) || result.array.(*array.Int8).Value(1) != 6 {
+			t.Errorf("expected int8 [3 6], got %s", result)
+		}
+
+		widened, err := ints.Add(1000)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer widened.Release()
+
+		if !arrow.TypeEqual(widened.DType(), arrow.PrimitiveTypes.Int64) {
+			t.Errorf("expected a literal beyond int8 to widen to int64, got %s", widened.DType())
+		}
+
+		floats := FromSlice("test_float32", []float32{1.5})
+		defer floats.Release()
+
+		scaled, err := floats.Sub(0.5)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer scaled.Release()
+
+		if !arrow.TypeEqual(scaled.DType(), arrow.PrimitiveTypes.Float32) || scaled.array.(*array.Float32).Value(0) != 1 {
+			t.Errorf("expected float32 [1], got %s", scaled)
+		}
+
+		chunked, err := s.Sub(1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer chunked.Release()
+
+		values, _, err := Values[int64](chunked)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if chunked.NumChunks() != 2 || values[0] != 0 || values[2] != 2 {
+			t.Errorf("expected [0 1 2] in 2 chunks, got %s", chunked)
+		}
+	})
+
+	t.Run("division semantics", func(t *testing.T) {
+		s := FromSlice("test_int64", []int64{7, -7, 1, 0})
+		defer s.Release()
+
+		divided, err := s.Div(0)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer divided.Release()
+
+		dividedArr := divided.array.(*array.Float64)
+		if !math.IsInf(dividedArr.Value(0), 1) || !math.IsInf(dividedArr.Value(1), -1) || !math.IsNaN(dividedArr.Value(3)) {
+			t.Errorf("expected [+Inf -Inf +Inf NaN], got %s", dividedArr)
+		}
+
+		floorDivided, err := s.FloorDiv(2)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer floorDivided.Release()
+
+		floorArr := floorDivided.array.(*array.Int64)
+		if floorArr.Value(0) != 3 || floorArr.Value(1) != -4 || floorArr.Value(2) != 0 {
+			t.Errorf("expected [3 -4 0 0], got %s", floorArr)
+		}
+
+		modded, err := s.Mod(-3)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer modded.Release()
+
+		modArr := modded.array.(*array.Int64)
+		if modArr.Value(0) != -2 || modArr.Value(1) != -1 || modArr.Value(2) != -2 || modArr.Value(3) != 0 {
+			t.Errorf("expected [-2 -1 -2 0], got %s", modArr)
+		}
+
+		byZero, err := s.FloorDiv(0)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer byZero.Release()
+
+		if byZero.NullCount() != 4 {
+			t.Errorf("expected an integer division by zero to be null, got %s", byZero)
+		}
+
+		powered, err := s.Pow(2)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer powered.Release()
+
+		if v := powered.array.(*array.Float64).Value(1); v != 49 {
+			t.Errorf("expected 49, got %v", v)
+		}
+	})
+
+	t.Run("integer overflow", func(t *testing.T) {
+		s := FromSlice("test_int8", []int8{100, -100, 1})
+		defer s.Release()
+
+		if _, err := s.Add(int8(100)); err == nil {
+			t.Errorf("expected overflow error, got nil")
+		}
+
+		wrapped, err := s.Add(int8(100), WithIntegerOverflow(utils.OverflowWrap))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer wrapped.Release()
+
+		if v := wrapped.array.(*array.Int8).Value(0); v != -56 {
+			t.Errorf("expected the wrapped sum -56, got %d", v)
+		}
+
+		saturated, err := s.Mul(int8(2), WithIntegerOverflow(utils.OverflowSaturate))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer saturated.Release()
+
+		saturatedArr := saturated.array.(*array.Int8)
+		if saturatedArr.Value(0) != math.MaxInt8 || saturatedArr.Value(1) != math.MinInt8 || saturatedArr.Value(2) != 2 {
+			t.Errorf("expected [127 -128 2], got %s", saturatedArr)
+		}
+
+		promoted, err := s.Mul(int8(2), WithIntegerOverflow(utils.OverflowPromoteToDecimal))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer promoted.Release()
+
+		if promoted.DType().ID() != arrow.DECIMAL128 || promoted.array.(*array.Decimal128).Value(0).LowBits() != 200 {
+			t.Errorf("expected the exact decimal product 200, got %s", promoted)
+		}
+	})
+
+	t.Run("negate", func(t *testing.T) {
+		s := FromSlice("test_int64", []int64{1, math.MinInt64})
+		defer s.Release()
+
+		if _, err := s.Neg(); err == nil {
+			t.Errorf("expected overflow error, got nil")
+		}
+
+		negated, err := s.Neg(WithIntegerOverflow(utils.OverflowSaturate))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer negated.Release()
+
+		negatedArr := negated.array.(*array.Int64)
+		if negatedArr.Value(0) != -1 || negatedArr.Value(1) != math.MaxInt64 {
+			t.Errorf("expected [-1 max], got %s", negatedArr)
+		}
+
+		unsigned := FromSlice("test_uint8", []uint8{1})
+		defer unsigned.Release()
+		if _, err := unsigned.Neg(); err == nil {
+			t.Errorf("expected unsigned negation error, got nil")
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		s := FromSlice("test_int64", []int64{})
+		defer s.Release()
+		other := FromSlice("test_float64", []float64{})
+		defer other.Release()
+
+		added, err := s.Add(1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer added.Release()
+
+		sum, err := s.Add(other)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer sum.Release()
+
+		negated, err := s.Neg()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer negated.Release()
+
+		if added.Len() != 0 || sum.Len() != 0 || negated.Len() != 0 {
+			t.Errorf("expected empty results, got %s, %s and %s", added, sum, negated)
+		}
+		if !arrow.TypeEqual(sum.DType(), arrow.PrimitiveTypes.Float64) {
+			t.Errorf("expected float64, got %s", sum.DType())
+		}
+	})
+
+	t.Run("non-numeric", func(t *testing.T) {
+		s := FromSlice("test_string", []string{"a"})
+		defer s.Release()
+
+		if _, err := s.Add(1); err == nil {
+			t.Errorf("expected unsupported type error, got nil")
+		}
+	})
+}
@@ -3,11 +3,18 @@ package series
 import (
 	"context"
 	"fmt"
+
+	"github.com/SHIMA0111/gleam/internal/compute/array"
+	internalUtils "github.com/SHIMA0111/gleam/internal/utils"
 	"github.com/apache/arrow-go/v18/arrow"
-	"github.com/apache/arrow-go/v18/arrow/compute"
+	"github.com/apache/arrow-go/v18/arrow/decimal128"
+	"github.com/apache/arrow-go/v18/arrow/decimal256"
 )
 
 // DataType represents a set of integer constants used to define various primitive and complex data types.
+// The unit and time zone of the temporal types, and the precision and scale of the decimal types,
+// are chosen by the CastOption of a Cast. There is no Struct data type, since a cast cannot choose the fields
+// of a struct; a Struct Series is created from its field Series with NewStructSeries instead.
 type DataType int
 
 const (
@@ -23,57 +30,160 @@ const (
 	Float64
 	String
 	Boolean
+	// Date32 is a calendar date stored as days since the UNIX epoch.
+	Date32
+	// Timestamp is an instant stored as a count of time units since the UNIX epoch, with an optional time zone.
+	Timestamp
+	// Duration is a length of time stored as a count of time units.
+	Duration
+	// Time64 is a time of day stored as a count of microseconds or nanoseconds since midnight.
+	Time64
+	// Decimal128 is an exact decimal number of up to 38 digits, with a fixed number of fractional digits (the scale).
+	Decimal128
+	// Decimal256 is an exact decimal number of up to 76 digits, with a fixed number of fractional digits (the scale).
+	Decimal256
+	// Categorical is a dictionary-encoded String: each distinct value is stored once and elements hold its code.
+	Categorical
+	// List is a variable-length list of elements of one data type, chosen by WithElementType.
+	List
+	// Binary is a variable-length byte string with 32-bit offsets, so an array holds up to 2 GiB of data.
+	Binary
+	// LargeString is a String with 64-bit offsets, which has no 2 GiB limit.
+	LargeString
+	// LargeBinary is a Binary with 64-bit offsets, which has no 2 GiB limit.
+	LargeBinary
+	// FixedSizeBinary is a byte string of a fixed width, chosen by WithByteWidth, such as a hash.
+	FixedSizeBinary
 	Unsupported
 )
 
-func (dt DataType) dataType() arrow.DataType {
+func (dt DataType) dataType(options castOptions) (arrow.DataType, error) {
 	switch dt {
 	case Int8:
-		return arrow.PrimitiveTypes.Int8
+		return arrow.PrimitiveTypes.Int8, nil
 	case Int16:
-		return arrow.PrimitiveTypes.Int16
+		return arrow.PrimitiveTypes.Int16, nil
 	case Int32:
-		return arrow.PrimitiveTypes.Int32
+		return arrow.PrimitiveTypes.Int32, nil
 	case Int64:
-		return arrow.PrimitiveTypes.Int64
+		return arrow.PrimitiveTypes.Int64, nil
 	case UInt8:
-		return arrow.PrimitiveTypes.Uint8
+		return arrow.PrimitiveTypes.Uint8, nil
 	case UInt16:
-		return arrow.PrimitiveTypes.Uint16
+		return arrow.PrimitiveTypes.Uint16, nil
 	case UInt32:
-		return arrow.PrimitiveTypes.Uint32
+		return arrow.PrimitiveTypes.Uint32, nil
 	case UInt64:
-		return arrow.PrimitiveTypes.Uint64
+		return arrow.PrimitiveTypes.Uint64, nil
 	case Float32:
-		return arrow.PrimitiveTypes.Float32
+		return arrow.PrimitiveTypes.Float32, nil
 	case Float64:
-		return arrow.PrimitiveTypes.Float64
+		return arrow.PrimitiveTypes.Float64, nil
 	case String:
-		return arrow.BinaryTypes.String
+		return arrow.BinaryTypes.String, nil
 	case Boolean:
-		return arrow.FixedWidthTypes.Boolean
+		return arrow.FixedWidthTypes.Boolean, nil
+	case Date32:
+		return arrow.FixedWidthTypes.Date32, nil
+	case Timestamp:
+		if _, err := internalUtils.Location(options.timeZone); err != nil {
+			return nil, err
+		}
+		return &arrow.TimestampType{Unit: options.unit, TimeZone: options.timeZone}, nil
+	case Duration:
+		return &arrow.DurationType{Unit: options.unit}, nil
+	case Time64:
+		if options.unit != arrow.Microsecond && options.unit != arrow.Nanosecond {
+			return nil, fmt.Errorf("time64 needs a microsecond or nanosecond unit, got %s", options.unit)
+		}
+		return &arrow.Time64Type{Unit: options.unit}, nil
+	case Decimal128:
+		return decimalType(options, decimal128.MaxPrecision, func(precision, scale int32) arrow.DataType {
+			return &arrow.Decimal128Type{Precision: precision, Scale: scale}
+		})
+	case Decimal256:
+		return decimalType(options, decimal256.MaxPrecision, func(precision, scale int32) arrow.DataType {
+			return &arrow.Decimal256Type{Precision: precision, Scale: scale}
+		})
+	case Categorical:
+		return &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int32, ValueType: arrow.BinaryTypes.String}, nil
+	case List:
+		if options.element == List {
+			return nil, fmt.Errorf("list element type cannot be List")
+		}
+		element, err := options.element.dataType(options)
+		if err != nil {
+			return nil, fmt.Errorf("list cast needs a valid element type: %w", err)
+		}
+		return arrow.ListOf(element), nil
+	case Binary:
+		return arrow.BinaryTypes.Binary, nil
+	case LargeString:
+		return arrow.BinaryTypes.LargeString, nil
+	case LargeBinary:
+		return arrow.BinaryTypes.LargeBinary, nil
+	case FixedSizeBinary:
+		if options.byteWidth < 1 {
+			return nil, fmt.Errorf("fixed size binary needs a positive byte width, got %d", options.byteWidth)
+		}
+		return &arrow.FixedSizeBinaryType{ByteWidth: options.byteWidth}, nil
 	default:
-		panic("unsupported data type")
+		return nil, fmt.Errorf("cannot convert unsupported data type")
 	}
 }
 
 // Cast changes the data type of Series to the specified dtype if a valid conversion exists, returning a new Series.
-func (s *Series) Cast(dtype DataType) (*Series, error) {
-	if dtype == Unsupported {
-		return nil, fmt.Errorf("cannot convert unsupported data type")
+// Strings are parsed into and temporal values formatted as strings with the layout of WithLayout.
+// Numbers and strings cast to a decimal type are rounded to its scale as set by WithRoundingMode.
+// Values cast to Categorical are cast to String and then encoded; a Categorical Series is decoded before other casts.
+func (s *Series) Cast(dtype DataType, opts ...CastOption) (*Series, error) {
+	return s.CastCtx(context.Background(), dtype, opts...)
+}
+
+// CastCtx is Cast with a caller-provided context.
+func (s *Series) CastCtx(ctx context.Context, dtype DataType, opts ...CastOption) (*Series, error) {
+	options := newCastOptions(opts)
+
+	target, err := dtype.dataType(options)
+	if err != nil {
+		return nil, err
 	}
 
-	if s.DType() == dtype.dataType() {
-		return s, nil
+	if arrow.TypeEqual(s.DType(), target) {
+		// The result shares the chunks but holds its own references, so the caller always owns it
+		return s.mapChunks(func(chunk arrow.Array) (arrow.Array, error) {
+			chunk.Retain()
+			return chunk, nil
+		})
 	}
 
-	ctx := context.Background()
+	castOptions := array.CastOptions{
+		Layout:   options.layout,
+		Rounding: options.rounding,
+	}
 
-	castedArray, err := compute.CastToType(ctx, s.array, dtype.dataType())
-	if err != nil {
-		return nil, err
+	return s.mapChunks(func(chunk arrow.Array) (arrow.Array, error) {
+		return array.Cast(ctx, chunk, target, castOptions, s.mem)
+	})
+}
+
+// decimalType returns the decimal type with the precision and scale of the options. The precision defaults to maxPrecision.
+func decimalType(
+	options castOptions,
+	maxPrecision int32,
+	newType func(precision, scale int32) arrow.DataType,
+) (arrow.DataType, error) {
+	precision := options.precision
+	if precision == 0 {
+		precision = maxPrecision
+	}
+
+	if precision < 1 || precision > maxPrecision {
+		return nil, fmt.Errorf("decimal precision must be between 1 and %d, got %d", maxPrecision, precision)
+	}
+	if options.scale < 0 || options.scale > precision {
+		return nil, fmt.Errorf("decimal scale must be between 0 and the precision %d, got %d", precision, options.scale)
 	}
-	defer castedArray.Release()
 
-	return NewSeries(s.name, castedArray), nil
+	return newType(precision, options.scale), nil
 }
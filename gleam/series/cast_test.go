@@ -2,10 +2,13 @@ package series
 
 import (
 	"testing"
+	"time"
 
 	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
 	"github.com/apache/arrow-go/v18/arrow/memory"
+
+	"github.com/SHIMA0111/gleam/gleam/utils"
 )
 
 func TestSeries_Cast(t *testing.T) {
@@ -218,8 +221,11 @@ func TestSeries_Cast(t *testing.T) {
 	})
 
 	t.Run("same type", func(t *testing.T) {
+		checked := memory.NewCheckedAllocator(memory.NewGoAllocator())
+		defer checked.AssertSize(t, 0)
+
 		// Create a builder for int32 values
-		builder := array.NewInt32Builder(mem)
+		builder := array.NewInt32Builder(checked)
 		defer builder.Release()
 
 		// Append values
@@ -229,17 +235,22 @@ func TestSeries_Cast(t *testing.T) {
 
 		// Create a series
 		s := NewSeries("test_int32", arr)
-		defer s.Release()
 
 		// Cast to the same type
 		result, err := s.Cast(Int32)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
+		defer result.Release()
 
-		// For same type, the original series should be returned
-		if result != s {
-			t.Errorf("expected the same series to be returned for same type cast")
+		// For same type, a new Series shares the chunks and outlives the original series
+		if result == s {
+			t.Errorf("expected a new series to be returned for same type cast")
+		}
+		s.Release()
+
+		if result.Len() != 3 || result.array.(*array.Int32).Value(2) != 3 {
+			t.Errorf("expected [1 2 3], got %s", result.array)
 		}
 	})
 
@@ -341,4 +352,327 @@ func TestSeries_Cast(t *testing.T) {
 			t.Fatalf("expected error for unsupported type, got nil")
 		}
 	})
+
+	t.Run("string to timestamp with layout and zone", func(t *testing.T) {
+		s := FromSlice("test_string", []string{"2024/03/01 09:30", "2024/03/02 18:00"})
+		defer s.Release()
+
+		result, err := s.Cast(
+			Timestamp,
+			WithTimeUnit(arrow.Millisecond),
+			WithTimeZone("Asia/Tokyo"),
+			WithLayout("2006/01/02 15:04"),
+		)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		expectedType := &arrow.TimestampType{Unit: arrow.Millisecond, TimeZone: "Asia/Tokyo"}
+		if !arrow.TypeEqual(result.DType(), expectedType) {
+			t.Fatalf("expected type %s, got %s", expectedType, result.DType())
+		}
+
+		// 09:30 in Tokyo is 00:30 UTC
+		expected := time.Date(2024, 3, 1, 0, 30, 0, 0, time.UTC).UnixMilli()
+		if v := result.array.(*array.Timestamp).Value(0); int64(v) != expected {
+			t.Errorf("expected %d, got %d", expected, v)
+		}
+
+		formatted, err := result.Cast(String, WithLayout(time.DateTime))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer formatted.Release()
+
+		if v := formatted.array.(*array.String).Value(1); v != "2024-03-02 18:00:00" {
+			t.Errorf("expected %q, got %q", "2024-03-02 18:00:00", v)
+		}
+	})
+
+	t.Run("string to date32, time64 and duration", func(t *testing.T) {
+		dates, err := FromSliceWithValidity("test_date", []string{"2024-02-29", ""}, []bool{true, false})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer dates.Release()
+
+		dateResult, err := dates.Cast(Date32)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer dateResult.Release()
+
+		dateArr := dateResult.array.(*array.Date32)
+		if v := dateArr.Value(0).ToTime(); !v.Equal(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("expected 2024-02-29, got %s", v)
+		}
+		if !dateArr.IsNull(1) {
+			t.Errorf("expected the null element to stay null")
+		}
+
+		times := FromSlice("test_time", []string{"13:45:30.5"})
+		defer times.Release()
+
+		timeResult, err := times.Cast(Time64, WithTimeUnit(arrow.Nanosecond))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer timeResult.Release()
+
+		expectedTime := 13*time.Hour + 45*time.Minute + 30500*time.Millisecond
+		if v := timeResult.array.(*array.Time64).Value(0); time.Duration(v) != expectedTime {
+			t.Errorf("expected %s, got %s", expectedTime, time.Duration(v))
+		}
+
+		durations := FromSlice("test_duration", []string{"1h30m"})
+		defer durations.Release()
+
+		durationResult, err := durations.Cast(Duration, WithTimeUnit(arrow.Second))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer durationResult.Release()
+
+		if v := durationResult.array.(*array.Duration).Value(0); v != 5400 {
+			t.Errorf("expected 5400 seconds, got %d", v)
+		}
+	})
+
+	t.Run("invalid temporal casts", func(t *testing.T) {
+		s := FromSlice("test_string", []string{"not a date"})
+		defer s.Release()
+
+		if _, err := s.Cast(Date32); err == nil {
+			t.Errorf("expected parse error, got nil")
+		}
+		if _, err := s.Cast(Timestamp, WithTimeZone("Mars/Olympus_Mons")); err == nil {
+			t.Errorf("expected unknown time zone error, got nil")
+		}
+		if _, err := s.Cast(Time64, WithTimeUnit(arrow.Second)); err == nil {
+			t.Errorf("expected time unit error, got nil")
+		}
+	})
+
+	t.Run("string and float to decimal with rounding", func(t *testing.T) {
+		s, err := FromSliceWithValidity("test_price", []string{"2.345", "-2.345", "2.355", ""}, []bool{true, true, true, false})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer s.Release()
+
+		tests := []struct {
+			mode     utils.RoundingMode
+			expected []string
+		}{
+			{utils.RoundHalfEven, []string{"2.34", "-2.34", "2.36"}},
+			{utils.RoundHalfUp, []string{"2.35", "-2.35", "2.36"}},
+			{utils.RoundDown, []string{"2.34", "-2.34", "2.35"}},
+			{utils.RoundFloor, []string{"2.34", "-2.35", "2.35"}},
+		}
+
+		for _, tt := range tests {
+			result, err := s.Cast(Decimal128, WithDecimal(10, 2), WithRoundingMode(tt.mode))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			expectedType := &arrow.Decimal128Type{Precision: 10, Scale: 2}
+			if !arrow.TypeEqual(result.DType(), expectedType) {
+				t.Errorf("expected type %s, got %s", expectedType, result.DType())
+			}
+
+			resultArr := result.array.(*array.Decimal128)
+			for i, v := range tt.expected {
+				if got := resultArr.Value(i).ToString(2); got != v {
+					t.Errorf("%s: at index %d: expected %s, got %s", tt.mode, i, v, got)
+				}
+			}
+			if !resultArr.IsNull(3) {
+				t.Errorf("%s: expected the null element to stay null", tt.mode)
+			}
+			result.Release()
+		}
+
+		// 0.1 is not exact as a float64, but rounds to the decimal 0.10
+		floats := FromSlice("test_float", []float64{0.1, 1.005})
+		defer floats.Release()
+
+		floatResult, err := floats.Cast(Decimal256, WithDecimal(40, 2))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer floatResult.Release()
+
+		floatArr := floatResult.array.(*array.Decimal256)
+		if got := floatArr.Value(0).ToString(2); got != "0.10" {
+			t.Errorf("expected 0.10, got %s", got)
+		}
+		// 1.005 is slightly below 1.005 as a float64, so it rounds down
+		if got := floatArr.Value(1).ToString(2); got != "1.00" {
+			t.Errorf("expected 1.00, got %s", got)
+		}
+	})
+
+	t.Run("decimal to string and rescale", func(t *testing.T) {
+		s := FromSlice("test_price", []string{"19.99", "-0.5"})
+		defer s.Release()
+
+		decimals, err := s.Cast(Decimal128, WithDecimal(10, 2))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer decimals.Release()
+
+		rescaled, err := decimals.Cast(Decimal128, WithDecimal(10, 0), WithRoundingMode(utils.RoundHalfUp))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer rescaled.Release()
+
+		formatted, err := rescaled.Cast(String)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer formatted.Release()
+
+		formattedArr := formatted.array.(*array.String)
+		for i, v := range []string{"20", "-1"} {
+			if got := formattedArr.Value(i); got != v {
+				t.Errorf("at index %d: expected %q, got %q", i, v, got)
+			}
+		}
+	})
+
+	t.Run("invalid decimal casts", func(t *testing.T) {
+		s := FromSlice("test_price", []string{"12345.6"})
+		defer s.Release()
+
+		if _, err := s.Cast(Decimal128, WithDecimal(4, 1)); err == nil {
+			t.Errorf("expected precision overflow error, got nil")
+		}
+		if _, err := s.Cast(Decimal128, WithDecimal(39, 0)); err == nil {
+			t.Errorf("expected invalid precision error, got nil")
+		}
+		if _, err := s.Cast(Decimal256, WithDecimal(10, 11)); err == nil {
+			t.Errorf("expected invalid scale error, got nil")
+		}
+
+		invalid := FromSlice("test_string", []string{"12.3.4"})
+		defer invalid.Release()
+
+		if _, err := invalid.Cast(Decimal128, WithDecimal(10, 2)); err == nil {
+			t.Errorf("expected parse error, got nil")
+		}
+	})
+
+	t.Run("string to categorical and back", func(t *testing.T) {
+		s, err := FromSliceWithValidity("test_country", []string{"jp", "us", "", "jp", "jp"}, []bool{true, true, false, true, true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer s.Release()
+
+		categorical, err := s.Cast(Categorical)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer categorical.Release()
+
+		dictArr := categorical.array.(*array.Dictionary)
+		if dictArr.Dictionary().Len() != 2 {
+			t.Errorf("expected 2 categories, got %d", dictArr.Dictionary().Len())
+		}
+		if dictArr.GetValueIndex(0) != dictArr.GetValueIndex(3) || !dictArr.IsNull(2) {
+			t.Errorf("expected equal values to share a code and the null to stay null, got %s", dictArr)
+		}
+
+		decoded, err := categorical.Cast(String)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer decoded.Release()
+
+		decodedArr := decoded.array.(*array.String)
+		for i, v := range []string{"jp", "us", "", "jp", "jp"} {
+			if i == 2 {
+				if !decodedArr.IsNull(i) {
+					t.Errorf("expected the null element to stay null")
+				}
+				continue
+			}
+			if decodedArr.Value(i) != v {
+				t.Errorf("at index %d: expected %q, got %q", i, v, decodedArr.Value(i))
+			}
+		}
+
+		// Other types are formatted as strings first
+		numbers := FromSlice("test_status", []int64{200, 404, 200})
+		defer numbers.Release()
+
+		numberCategories, err := numbers.Cast(Categorical)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer numberCategories.Release()
+
+		if v := numberCategories.array.(*array.Dictionary).Dictionary().(*array.String).Value(1); v != "404" {
+			t.Errorf("expected the category %q, got %q", "404", v)
+		}
+	})
+
+	t.Run("string to binary types", func(t *testing.T) {
+		s, err := FromSliceWithValidity("test_hash", []string{"ab", "cd", ""}, []bool{true, true, false})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer s.Release()
+
+		for _, tt := range []struct {
+			dtype    DataType
+			expected arrow.DataType
+		}{
+			{Binary, arrow.BinaryTypes.Binary},
+			{LargeString, arrow.BinaryTypes.LargeString},
+			{LargeBinary, arrow.BinaryTypes.LargeBinary},
+		} {
+			result, err := s.Cast(tt.dtype)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !arrow.TypeEqual(result.DType(), tt.expected) || result.NullCount() != 1 {
+				t.Errorf("expected %s with 1 null, got %s", tt.expected, result)
+			}
+			result.Release()
+		}
+
+		fixed, err := s.Cast(FixedSizeBinary, WithByteWidth(2))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer fixed.Release()
+
+		fixedArr := fixed.array.(*array.FixedSizeBinary)
+		if string(fixedArr.Value(1)) != "cd" || !fixedArr.IsNull(2) {
+			t.Errorf("expected [ab cd null], got %s", fixedArr)
+		}
+
+		// Back to String through the arrow cast kernels
+		decoded, err := fixed.Cast(String)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer decoded.Release()
+
+		if v := decoded.array.(*array.String).Value(0); v != "ab" {
+			t.Errorf("expected %q, got %q", "ab", v)
+		}
+
+		if _, err := s.Cast(FixedSizeBinary, WithByteWidth(3)); err == nil {
+			t.Errorf("expected byte width error, got nil")
+		}
+		if _, err := s.Cast(FixedSizeBinary); err == nil {
+			t.Errorf("expected missing byte width error, got nil")
+		}
+	})
 }
@@ -0,0 +1,48 @@
+package series
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apache/arrow-go/v18/arrow"
+
+	"github.com/SHIMA0111/gleam/internal/compute/array"
+)
+
+// Rechunk returns the Series as a single contiguous chunk, copying the chunks into one array.
+// A Series that already has a single chunk shares its memory with the result.
+func (s *Series) Rechunk() (*Series, error) {
+	return s.RechunkCtx(context.Background())
+}
+
+// RechunkCtx is Rechunk with a caller-provided context.
+func (s *Series) RechunkCtx(ctx context.Context) (*Series, error) {
+	if s.array != nil {
+		s.array.Retain()
+		return s.withChunks([]arrow.Array{s.array}), nil
+	}
+
+	concatenated, err := array.Concat(ctx, s.chunks, s.mem)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.withChunks([]arrow.Array{concatenated}), nil
+}
+
+// Append returns a Series with the chunks of other after the chunks of s, without copying them.
+// The result has the name and allocator of s. Returns an error if the Series differ in data type.
+func (s *Series) Append(other *Series) (*Series, error) {
+	if !arrow.TypeEqual(s.DType(), other.DType()) {
+		return nil, fmt.Errorf("cannot append %s Series to %s Series", other.DType(), s.DType())
+	}
+
+	chunks := make([]arrow.Array, 0, len(s.chunks)+len(other.chunks))
+	chunks = append(chunks, s.chunks...)
+	chunks = append(chunks, other.chunks...)
+	for _, chunk := range chunks {
+		chunk.Retain()
+	}
+
+	return newChunkedSeries(s.name, chunks, s.datatype, s.mem), nil
+}
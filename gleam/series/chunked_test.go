@@ -0,0 +1,244 @@
+package series
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+
+	"github.com/SHIMA0111/gleam/gleam/utils"
+)
+
+// newChunkedInt64 creates an Int64 Series with a chunk per slice, where the value 0 is null.
+func newChunkedInt64(t *testing.T, chunks ...[]int64) *Series {
+	t.Helper()
+
+	mem := memory.NewGoAllocator()
+	arrs := make([]arrow.Array, len(chunks))
+	for i, values := range chunks {
+		builder := array.NewInt64Builder(mem)
+		for _, v := range values {
+			if v == 0 {
+				builder.AppendNull()
+			} else {
+				builder.Append(v)
+			}
+		}
+		arrs[i] = builder.NewArray()
+		builder.Release()
+	}
+
+	chunked := arrow.NewChunked(arrow.PrimitiveTypes.Int64, arrs)
+	defer chunked.Release()
+	for _, arr := range arrs {
+		arr.Release()
+	}
+
+	return NewChunkedSeries("test_chunked", chunked)
+}
+
+func TestChunkedSeries(t *testing.T) {
+	t.Run("element access", func(t *testing.T) {
+		s := newChunkedInt64(t, []int64{1, 2}, []int64{}, []int64{0, 4, 5})
+		defer s.Release()
+
+		if s.NumChunks() != 3 || s.Len() != 5 || s.NullCount() != 1 {
+			t.Fatalf("expected 3 chunks of 5 elements with 1 null, got %s", s)
+		}
+		if !s.IsNull(2) || !s.IsValid(4) || !s.IsValid(1) {
+			t.Errorf("expected only the element 2 to be null, got %s", s)
+		}
+
+		values, valid, err := Values[int64](s)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(values) != 5 || values[1] != 2 || valid[2] || values[4] != 5 {
+			t.Errorf("expected [1 2 null 4 5], got %v %v", values, valid)
+		}
+
+		if _, err := ValuesView[int64](s); err == nil || !strings.Contains(err.Error(), "Rechunk") {
+			t.Errorf("expected chunked view error, got %v", err)
+		}
+	})
+
+	t.Run("aggregations combine the chunks", func(t *testing.T) {
+		s := newChunkedInt64(t, []int64{1, 2}, []int64{}, []int64{0, 4, 5})
+		defer s.Release()
+
+		sum, err := s.Sum()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer sum.Release()
+		if v := sum.array.(*array.Int64).Value(0); v != 12 {
+			t.Errorf("expected sum 12, got %v", v)
+		}
+
+		mean, err := s.Mean()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer mean.Release()
+		if v := mean.array.(*array.Float64).Value(0); v != 3 {
+			t.Errorf("expected mean 3, got %v", v)
+		}
+
+		minimum, err := s.Min()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer minimum.Release()
+		if v := minimum.array.(*array.Int64).Value(0); v != 1 {
+			t.Errorf("expected min 1, got %v", v)
+		}
+
+		maximum, err := s.Max()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer maximum.Release()
+		if v := maximum.array.(*array.Int64).Value(0); v != 5 {
+			t.Errorf("expected max 5, got %v", v)
+		}
+
+		countValue, err := s.CountValue()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if count, err := countValue.Int64(); err != nil || count != 4 {
+			t.Errorf("expected count 4, got %s (%v)", countValue, err)
+		}
+	})
+
+	t.Run("where, comparison and cast keep the chunks", func(t *testing.T) {
+		s := newChunkedInt64(t, []int64{1, 2}, []int64{0, 4, 5})
+		defer s.Release()
+
+		filtered, err := s.Where(utils.Greater, 1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer filtered.Release()
+
+		values, _, err := Values[int64](filtered)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if filtered.NumChunks() != 2 || len(values) != 3 || values[0] != 2 || values[2] != 5 {
+			t.Errorf("expected [2 4 5] in 2 chunks, got %s", filtered)
+		}
+
+		mask, err := s.Comparison(utils.Greater, 1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer mask.Release()
+
+		maskArr := mask.(*array.Boolean)
+		if maskArr.Len() != 5 || maskArr.Value(0) || !maskArr.IsNull(2) || !maskArr.Value(4) {
+			t.Errorf("expected [false true null true true], got %s", maskArr)
+		}
+
+		casted, err := s.Cast(Float64)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer casted.Release()
+
+		if casted.NumChunks() != 2 || !arrow.TypeEqual(casted.DType(), arrow.PrimitiveTypes.Float64) {
+			t.Errorf("expected 2 float64 chunks, got %s", casted)
+		}
+	})
+
+	t.Run("rechunk", func(t *testing.T) {
+		s := newChunkedInt64(t, []int64{1, 2}, []int64{0, 4})
+		defer s.Release()
+
+		rechunked, err := s.Rechunk()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer rechunked.Release()
+
+		if rechunked.NumChunks() != 1 || rechunked.Len() != 4 || !rechunked.IsNull(2) {
+			t.Errorf("expected a single chunk [1 2 null 4], got %s", rechunked)
+		}
+
+		view, err := ValuesView[int64](rechunked)
+		if err == nil {
+			t.Errorf("expected null view error, got %v", view)
+		}
+
+		again, err := rechunked.Rechunk()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer again.Release()
+
+		if again.array != rechunked.array {
+			t.Errorf("expected a single-chunk Series to share its array")
+		}
+	})
+
+	t.Run("append", func(t *testing.T) {
+		first := FromSlice("first", []int64{1, 2})
+		defer first.Release()
+		second := FromSlice("second", []int64{3})
+		defer second.Release()
+
+		appended, err := first.Append(second)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer appended.Release()
+
+		if appended.Name() != "first" || appended.NumChunks() != 2 || appended.Len() != 3 {
+			t.Errorf("expected 3 elements in 2 chunks named first, got %s", appended)
+		}
+		if appended.chunks[1] != second.array {
+			t.Errorf("expected the appended chunk to share the array of the Series")
+		}
+
+		chunked := appended.Chunked()
+		defer chunked.Release()
+		if chunked.Len() != 3 {
+			t.Errorf("expected a chunked array of 3 elements, got %d", chunked.Len())
+		}
+
+		strs := FromSlice("test_string", []string{"a"})
+		defer strs.Release()
+		if _, err := first.Append(strs); err == nil {
+			t.Errorf("expected type mismatch error, got nil")
+		}
+	})
+
+	t.Run("chunked lists need rechunk", func(t *testing.T) {
+		lists := FromListSlice("test_tags", [][]string{{"a"}})
+		defer lists.Release()
+
+		appended, err := lists.Append(lists)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer appended.Release()
+
+		if _, err := appended.List(); err == nil || !strings.Contains(err.Error(), "Rechunk") {
+			t.Errorf("expected chunked list error, got %v", err)
+		}
+	})
+
+	t.Run("no chunks", func(t *testing.T) {
+		chunked := arrow.NewChunked(arrow.PrimitiveTypes.Int64, nil)
+		defer chunked.Release()
+
+		s := NewChunkedSeries("test_empty", chunked)
+		defer s.Release()
+
+		if s.Len() != 0 || !arrow.TypeEqual(s.DType(), arrow.PrimitiveTypes.Int64) {
+			t.Errorf("expected an empty int64 Series, got %s", s)
+		}
+	})
+}
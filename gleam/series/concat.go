@@ -0,0 +1,40 @@
+package series
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apache/arrow-go/v18/arrow"
+
+	"github.com/SHIMA0111/gleam/internal/compute/array"
+)
+
+// Concat appends the Series one after another into a new Series with the name and allocator of the first one.
+// The Series must have the same data type, except that String and LargeString, or Binary and LargeBinary,
+// can be mixed: they are concatenated as LargeString or LargeBinary if one of them is large, or if their data
+// would exceed the 2 GiB that the 32-bit offsets of String and Binary can address.
+// The result has a single chunk; see Append to join Series without copying.
+func Concat(series ...*Series) (*Series, error) {
+	return ConcatCtx(context.Background(), series...)
+}
+
+// ConcatCtx is Concat with a caller-provided context.
+func ConcatCtx(ctx context.Context, series ...*Series) (*Series, error) {
+	if len(series) == 0 {
+		return nil, fmt.Errorf("cannot concatenate no Series")
+	}
+
+	arrs := make([]arrow.Array, 0, len(series))
+	for _, s := range series {
+		arrs = append(arrs, s.chunks...)
+	}
+
+	first := series[0]
+	concatenated, err := array.Concat(ctx, arrs, first.mem)
+	if err != nil {
+		return nil, err
+	}
+	defer concatenated.Release()
+
+	return NewSeriesWithAllocator(first.name, concatenated, first.mem), nil
+}
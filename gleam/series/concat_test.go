@@ -0,0 +1,95 @@
+package series
+
+import (
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+)
+
+func TestConcat(t *testing.T) {
+	t.Run("same type", func(t *testing.T) {
+		first := FromSlice("test_int64", []int64{1, 2})
+		defer first.Release()
+		second, err := FromSliceWithValidity("other", []int64{3, 0}, []bool{true, false})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer second.Release()
+
+		result, err := Concat(first, second)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		if result.Name() != "test_int64" || result.Len() != 4 || result.NullCount() != 1 {
+			t.Errorf("expected 4 elements with 1 null named test_int64, got %s", result)
+		}
+	})
+
+	t.Run("string and large string promote to large string", func(t *testing.T) {
+		small := FromSlice("test_string", []string{"a", "b"})
+		defer small.Release()
+
+		values := FromSlice("test_large", []string{"c"})
+		defer values.Release()
+
+		large, err := values.Cast(LargeString)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer large.Release()
+
+		result, err := Concat(small, large)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		if !arrow.TypeEqual(result.DType(), arrow.BinaryTypes.LargeString) {
+			t.Errorf("expected large_utf8, got %s", result.DType())
+		}
+		if v := result.array.(*array.LargeString).Value(2); v != "c" {
+			t.Errorf("expected %q, got %q", "c", v)
+		}
+	})
+
+	t.Run("large strings keep their type", func(t *testing.T) {
+		values := FromSlice("test_large", []string{"a", "b"})
+		defer values.Release()
+
+		large, err := values.Cast(LargeString)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer large.Release()
+
+		result, err := Concat(large, large)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		if !arrow.TypeEqual(result.DType(), arrow.BinaryTypes.LargeString) || result.Len() != 4 {
+			t.Errorf("expected 4 large_utf8 elements, got %s", result)
+		}
+		if v := result.array.(*array.LargeString).Value(3); v != "b" {
+			t.Errorf("expected %q, got %q", "b", v)
+		}
+	})
+
+	t.Run("mismatched types", func(t *testing.T) {
+		ints := FromSlice("test_int64", []int64{1})
+		defer ints.Release()
+		strs := FromSlice("test_string", []string{"a"})
+		defer strs.Release()
+
+		if _, err := Concat(ints, strs); err == nil {
+			t.Errorf("expected type mismatch error, got nil")
+		}
+		if _, err := Concat(); err == nil {
+			t.Errorf("expected error for no Series, got nil")
+		}
+	})
+}
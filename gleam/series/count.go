@@ -1,25 +1,73 @@
 package series
 
 import (
+	"context"
+
+	"github.com/SHIMA0111/gleam/internal/compute/parallel"
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
 	"github.com/apache/arrow-go/v18/arrow/scalar"
 )
 
+// Count returns the number of non-null elements in the Series as a one-row Int64 Series.
 func (s *Series) Count() (*Series, error) {
-	count, err := s.count()
+	return s.CountCtx(context.Background())
+}
+
+// CountCtx is Count with a caller-provided context.
+func (s *Series) CountCtx(ctx context.Context) (*Series, error) {
+	count, err := s.count(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	scl := scalar.NewInt64Scalar(count)
-	newArray, err := scalar.MakeArrayFromScalar(scl, 1, s.mem)
+	return s.scalarSeries(scalar.NewInt64Scalar(count))
+}
+
+// CountValue is Count returning the number of non-null elements as an Int64 Scalar instead of a one-row Series.
+func (s *Series) CountValue() (Scalar, error) {
+	return s.CountValueCtx(context.Background())
+}
+
+// CountValueCtx is CountValue with a caller-provided context.
+func (s *Series) CountValueCtx(ctx context.Context) (Scalar, error) {
+	count, err := s.count(ctx)
 	if err != nil {
-		return nil, err
+		return Scalar{}, err
+	}
+
+	return Scalar{value: scalar.NewInt64Scalar(count)}, nil
+}
+
+func (s *Series) count(ctx context.Context) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	var count int64
+	for _, chunk := range s.chunks {
+		// Arrow caches the null count, so it only has to be counted for slices whose nulls were never counted
+		if chunk.Len() < ConcurrentReduceThreshold || chunk.Data().NullN() != array.UnknownNullCount {
+			chunkCount, err := countValid(ctx, chunk)
+			if err != nil {
+				return 0, err
+			}
+			count += chunkCount
+			continue
+		}
+
+		chunkCount, err := parallel.Reduce(ctx, chunk, parallel.ChunkSize(chunk.Len()), countValid, func(a, b int64) int64 {
+			return a + b
+		})
+		if err != nil {
+			return 0, err
+		}
+		count += chunkCount
 	}
-	defer newArray.Release()
 
-	return NewSeries(s.name, newArray), nil
+	return count, nil
 }
 
-func (s *Series) count() (int64, error) {
-	return int64(s.array.Len() - s.array.NullN()), nil
+func countValid(_ context.Context, arr arrow.Array) (int64, error) {
+	return int64(arr.Len() - arr.NullN()), nil
 }
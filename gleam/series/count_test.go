@@ -227,4 +227,69 @@ func TestSeries_Count(t *testing.T) {
 			t.Errorf("expected count %d, got %d", expectedCount, resultArr.Value(0))
 		}
 	})
+
+	t.Run("concurrent count of a slice", func(t *testing.T) {
+		builder := array.NewInt32Builder(mem)
+		defer builder.Release()
+
+		// Append enough values to take the concurrent path, every 3rd value null
+		const size = ConcurrentReduceThreshold * 3
+		values := make([]int32, size)
+		valids := make([]bool, size)
+		for i := 0; i < size; i++ {
+			values[i] = int32(i)
+			valids[i] = i%3 != 0
+		}
+
+		builder.AppendValues(values, valids)
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		// A slice does not know its null count until it is counted
+		sliced := array.NewSlice(arr, 1, size)
+		defer sliced.Release()
+
+		s := NewSeries("sliced", sliced)
+		defer s.Release()
+
+		result, err := s.Count()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		var expectedCount int64
+		for i := 1; i < size; i++ {
+			if valids[i] {
+				expectedCount++
+			}
+		}
+
+		resultArr := result.array.(*array.Int64)
+		if resultArr.Value(0) != expectedCount {
+			t.Errorf("expected count %d, got %d", expectedCount, resultArr.Value(0))
+		}
+	})
+
+	t.Run("categorical values", func(t *testing.T) {
+		values, err := FromSliceWithValidity("test_country", []string{"jp", "", "jp", "us"}, []bool{true, false, true, true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer values.Release()
+
+		s, err := values.Cast(Categorical)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer s.Release()
+
+		countValue, err := s.CountValue()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if count, err := countValue.Int64(); err != nil || count != 3 {
+			t.Errorf("expected count 3, got %s (%v)", countValue, err)
+		}
+	})
 }
@@ -0,0 +1,160 @@
+package series
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+)
+
+// NumericElement is a Go type of a numeric Series data type.
+type NumericElement interface {
+	int8 | int16 | int32 | int64 | uint8 | uint16 | uint32 | uint64 | float32 | float64
+}
+
+// Element is a Go type that can be stored in a Series: a NumericElement, string for String, []byte for Binary
+// and bool for Boolean.
+type Element interface {
+	NumericElement | string | []byte | bool
+}
+
+// SeriesOption configures the construction of a Series.
+type SeriesOption func(*seriesOptions)
+
+type seriesOptions struct {
+	mem memory.Allocator
+}
+
+func newSeriesOptions(opts []SeriesOption) seriesOptions {
+	options := seriesOptions{
+		mem: memory.DefaultAllocator,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return options
+}
+
+// WithAllocator sets the allocator of a new Series. The default is memory.DefaultAllocator.
+func WithAllocator(mem memory.Allocator) SeriesOption {
+	return func(o *seriesOptions) {
+		o.mem = mem
+	}
+}
+
+// FromSlice creates a new Series without nulls holding a copy of the values.
+// The data type follows the element type, e.g. []int32 makes an Int32 Series, []string a String Series
+// and [][]byte a Binary Series.
+func FromSlice[T Element](name string, values []T, opts ...SeriesOption) *Series {
+	return fromSlice(name, values, nil, newSeriesOptions(opts))
+}
+
+// FromSliceWithValidity is FromSlice with a validity mask: the element i is null where valid[i] is false.
+// A nil mask makes every element valid. Returns an error if the mask and the values differ in length.
+func FromSliceWithValidity[T Element](name string, values []T, valid []bool, opts ...SeriesOption) (*Series, error) {
+	if valid != nil && len(valid) != len(values) {
+		return nil, fmt.Errorf("validity length %d does not match values length %d", len(valid), len(values))
+	}
+
+	return fromSlice(name, values, valid, newSeriesOptions(opts)), nil
+}
+
+// FromPtrSlice creates a new Series from pointers to the values, where a nil pointer is a null element.
+func FromPtrSlice[T Element](name string, values []*T, opts ...SeriesOption) *Series {
+	data := make([]T, len(values))
+	valid := make([]bool, len(values))
+	for i, v := range values {
+		if v != nil {
+			data[i] = *v
+			valid[i] = true
+		}
+	}
+
+	return fromSlice(name, data, valid, newSeriesOptions(opts))
+}
+
+// FromListSlice creates a new List Series holding a copy of the lists, where a nil list is a null element.
+// The element type follows T as for FromSlice; elements of the lists cannot be null.
+func FromListSlice[T Element](name string, lists [][]T, opts ...SeriesOption) *Series {
+	options := newSeriesOptions(opts)
+
+	empty := newArrayFromSlice[T](options.mem, nil, nil)
+	elementType := empty.DataType()
+	empty.Release()
+
+	builder := array.NewListBuilder(options.mem, elementType)
+	defer builder.Release()
+	valueBuilder := builder.ValueBuilder().(sliceBuilder[T])
+
+	for _, list := range lists {
+		if list == nil {
+			builder.AppendNull()
+			continue
+		}
+		builder.Append(true)
+		valueBuilder.AppendValues(list, nil)
+	}
+
+	arr := builder.NewArray()
+	defer arr.Release()
+
+	return NewSeriesWithAllocator(name, arr, options.mem)
+}
+
+func fromSlice[T Element](name string, values []T, valid []bool, options seriesOptions) *Series {
+	arr := newArrayFromSlice(options.mem, values, valid)
+	defer arr.Release()
+
+	return NewSeriesWithAllocator(name, arr, options.mem)
+}
+
+// sliceBuilder is an arrow array builder that appends a Go slice of values with a validity mask.
+type sliceBuilder[T Element] interface {
+	AppendValues(values []T, valid []bool)
+	NewArray() arrow.Array
+	Release()
+}
+
+func newArrayFromSlice[T Element](mem memory.Allocator, values []T, valid []bool) arrow.Array {
+	switch v := any(values).(type) {
+	case []int8:
+		return buildArray(array.NewInt8Builder(mem), v, valid)
+	case []int16:
+		return buildArray(array.NewInt16Builder(mem), v, valid)
+	case []int32:
+		return buildArray(array.NewInt32Builder(mem), v, valid)
+	case []int64:
+		return buildArray(array.NewInt64Builder(mem), v, valid)
+	case []uint8:
+		return buildArray(array.NewUint8Builder(mem), v, valid)
+	case []uint16:
+		return buildArray(array.NewUint16Builder(mem), v, valid)
+	case []uint32:
+		return buildArray(array.NewUint32Builder(mem), v, valid)
+	case []uint64:
+		return buildArray(array.NewUint64Builder(mem), v, valid)
+	case []float32:
+		return buildArray(array.NewFloat32Builder(mem), v, valid)
+	case []float64:
+		return buildArray(array.NewFloat64Builder(mem), v, valid)
+	case []string:
+		return buildArray(array.NewStringBuilder(mem), v, valid)
+	case [][]byte:
+		return buildArray(array.NewBinaryBuilder(mem, arrow.BinaryTypes.Binary), v, valid)
+	case []bool:
+		return buildArray(array.NewBooleanBuilder(mem), v, valid)
+	default:
+		// Unreachable: the Element constraint lists exactly the cases above
+		panic(fmt.Sprintf("unsupported element type %T", values))
+	}
+}
+
+func buildArray[T Element](builder sliceBuilder[T], values []T, valid []bool) arrow.Array {
+	defer builder.Release()
+
+	builder.AppendValues(values, valid)
+
+	return builder.NewArray()
+}
@@ -0,0 +1,95 @@
+package series
+
+import (
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+)
+
+func TestFromSlice(t *testing.T) {
+	t.Run("numeric slice", func(t *testing.T) {
+		mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+		defer mem.AssertSize(t, 0)
+
+		s := FromSlice("test_int32", []int32{1, 2, 3}, WithAllocator(mem))
+		defer s.Release()
+
+		if !arrow.TypeEqual(s.DType(), arrow.PrimitiveTypes.Int32) {
+			t.Fatalf("expected Int32 Series, got %s", s.DType())
+		}
+		if s.Len() != 3 || s.NullCount() != 0 {
+			t.Fatalf("expected 3 elements without nulls, got %d elements with %d nulls", s.Len(), s.NullCount())
+		}
+		if v := s.array.(*array.Int32).Value(2); v != 3 {
+			t.Errorf("expected 3, got %d", v)
+		}
+	})
+
+	t.Run("string and boolean slices", func(t *testing.T) {
+		strSeries := FromSlice("test_string", []string{"a", "b"})
+		defer strSeries.Release()
+
+		if v := strSeries.array.(*array.String).Value(1); v != "b" {
+			t.Errorf("expected %q, got %q", "b", v)
+		}
+
+		boolSeries := FromSlice("test_bool", []bool{true, false})
+		defer boolSeries.Release()
+
+		if !arrow.TypeEqual(boolSeries.DType(), arrow.FixedWidthTypes.Boolean) {
+			t.Errorf("expected Boolean Series, got %s", boolSeries.DType())
+		}
+	})
+
+	t.Run("binary slice", func(t *testing.T) {
+		mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+		defer mem.AssertSize(t, 0)
+
+		s := FromSlice("test_binary", [][]byte{[]byte("ab"), {0xff}}, WithAllocator(mem))
+		defer s.Release()
+
+		if !arrow.TypeEqual(s.DType(), arrow.BinaryTypes.Binary) {
+			t.Fatalf("expected Binary Series, got %s", s.DType())
+		}
+		if v := s.array.(*array.Binary).Value(1); len(v) != 1 || v[0] != 0xff {
+			t.Errorf("expected [0xff], got %v", v)
+		}
+	})
+
+	t.Run("validity mask", func(t *testing.T) {
+		mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+		defer mem.AssertSize(t, 0)
+
+		s, err := FromSliceWithValidity("test_valid", []float64{1.5, 0, 3.5}, []bool{true, false, true}, WithAllocator(mem))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer s.Release()
+
+		if s.NullCount() != 1 || !s.IsNull(1) {
+			t.Errorf("expected only element 1 to be null, got %d nulls", s.NullCount())
+		}
+	})
+
+	t.Run("validity length mismatch", func(t *testing.T) {
+		_, err := FromSliceWithValidity("test_mismatch", []int64{1, 2}, []bool{true})
+		if err == nil {
+			t.Fatalf("expected length mismatch error, got nil")
+		}
+	})
+
+	t.Run("pointer slice", func(t *testing.T) {
+		first, third := "x", "z"
+		s := FromPtrSlice("test_ptr", []*string{&first, nil, &third})
+		defer s.Release()
+
+		if !s.IsNull(1) || s.NullCount() != 1 {
+			t.Errorf("expected only element 1 to be null, got %d nulls", s.NullCount())
+		}
+		if v := s.array.(*array.String).Value(2); v != "z" {
+			t.Errorf("expected %q, got %q", "z", v)
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package series
+
+import (
+	"context"
+
+	"github.com/SHIMA0111/gleam/internal/compute/array"
+)
+
+// And combines two comparison arrays of the same length element-wise, e.g. the results of Series.Comparison,
+// into one that is true where both are true. Nulls follow the Kleene three-valued logic, where null is an
+// unknown value: false and null is false, and true and null is null.
+// The result is accepted by DataFrame.Where, which drops the rows where it is null.
+func And(left, right ComparisonArray) (ComparisonArray, error) {
+	return AndCtx(context.Background(), left, right)
+}
+
+// AndCtx is And with a caller-provided context.
+func AndCtx(ctx context.Context, left, right ComparisonArray) (ComparisonArray, error) {
+	return array.CombineMasks(ctx, array.MaskAnd, left, right)
+}
+
+// Or combines two comparison arrays into one that is true where either is true, see And.
+// true or null is true, and false or null is null.
+func Or(left, right ComparisonArray) (ComparisonArray, error) {
+	return OrCtx(context.Background(), left, right)
+}
+
+// OrCtx is Or with a caller-provided context.
+func OrCtx(ctx context.Context, left, right ComparisonArray) (ComparisonArray, error) {
+	return array.CombineMasks(ctx, array.MaskOr, left, right)
+}
+
+// Xor combines two comparison arrays into one that is true where exactly one is true, see And.
+// Xor with null is always null.
+func Xor(left, right ComparisonArray) (ComparisonArray, error) {
+	return XorCtx(context.Background(), left, right)
+}
+
+// XorCtx is Xor with a caller-provided context.
+func XorCtx(ctx context.Context, left, right ComparisonArray) (ComparisonArray, error) {
+	return array.CombineMasks(ctx, array.MaskXor, left, right)
+}
+
+// AndNot combines two comparison arrays into one that is true where left is true and right is false,
+// i.e. And with the negation of right, see And.
+func AndNot(left, right ComparisonArray) (ComparisonArray, error) {
+	return AndNotCtx(context.Background(), left, right)
+}
+
+// AndNotCtx is AndNot with a caller-provided context.
+func AndNotCtx(ctx context.Context, left, right ComparisonArray) (ComparisonArray, error) {
+	return array.CombineMasks(ctx, array.MaskAndNot, left, right)
+}
+
+// Not negates a comparison array element-wise; null stays null.
+func Not(mask ComparisonArray) (ComparisonArray, error) {
+	return NotCtx(context.Background(), mask)
+}
+
+// NotCtx is Not with a caller-provided context.
+func NotCtx(ctx context.Context, mask ComparisonArray) (ComparisonArray, error) {
+	return array.InvertMask(ctx, mask)
+}
+
+// Any reports whether any element of a comparison array is true. Nulls are skipped, so a mask of only
+// nulls, or an empty mask, has no true element.
+func Any(mask ComparisonArray) (bool, error) {
+	return AnyCtx(context.Background(), mask)
+}
+
+// AnyCtx is Any with a caller-provided context.
+func AnyCtx(ctx context.Context, mask ComparisonArray) (bool, error) {
+	trues, _, err := array.CountMask(ctx, mask)
+	if err != nil {
+		return false, err
+	}
+
+	return trues > 0, nil
+}
+
+// All reports whether every element of a comparison array is true. Nulls are skipped, so a mask of only
+// nulls, or an empty mask, is all true.
+func All(mask ComparisonArray) (bool, error) {
+	return AllCtx(context.Background(), mask)
+}
+
+// AllCtx is All with a caller-provided context.
+func AllCtx(ctx context.Context, mask ComparisonArray) (bool, error) {
+	_, falses, err := array.CountMask(ctx, mask)
+	if err != nil {
+		return false, err
+	}
+
+	return falses == 0, nil
+}
+
+// CountTrue returns the number of true elements of a comparison array, i.e. the number of rows
+// DataFrame.Where keeps. Nulls are not counted.
+func CountTrue(mask ComparisonArray) (int, error) {
+	return CountTrueCtx(context.Background(), mask)
+}
+
+// CountTrueCtx is CountTrue with a caller-provided context.
+func CountTrueCtx(ctx context.Context, mask ComparisonArray) (int, error) {
+	trues, _, err := array.CountMask(ctx, mask)
+	return trues, err
+}
@@ -0,0 +1,182 @@
+package series
+
+import (
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+
+	"github.com/SHIMA0111/gleam/gleam/utils"
+)
+
+// newMask builds a comparison array from the values, where a nil value is null.
+func newMask(values ...interface{}) ComparisonArray {
+	builder := array.NewBooleanBuilder(memory.NewGoAllocator())
+	defer builder.Release()
+
+	for _, v := range values {
+		if v == nil {
+			builder.AppendNull()
+			continue
+		}
+		builder.Append(v.(bool))
+	}
+
+	return builder.NewArray()
+}
+
+func TestMask_Combinators(t *testing.T) {
+	// Every pair of true, false and null
+	left := newMask(true, true, true, false, false, false, nil, nil, nil)
+	defer left.Release()
+	right := newMask(true, false, nil, true, false, nil, true, false, nil)
+	defer right.Release()
+
+	tests := []struct {
+		name     string
+		combine  func(left, right ComparisonArray) (ComparisonArray, error)
+		expected []interface{}
+	}{
+		{"and", And, []interface{}{true, false, nil, false, false, false, nil, false, nil}},
+		{"or", Or, []interface{}{true, true, true, true, false, nil, true, nil, nil}},
+		{"xor", Xor, []interface{}{false, true, nil, true, false, nil, nil, nil, nil}},
+		{"and not", AndNot, []interface{}{false, true, nil, false, false, false, false, nil, nil}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.combine(left, right)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer result.Release()
+
+			resultArr := result.(*array.Boolean)
+			for i, v := range tt.expected {
+				if v == nil {
+					if resultArr.IsValid(i) {
+						t.Errorf("element %d: expected null, got %v", i, resultArr.Value(i))
+					}
+					continue
+				}
+				if resultArr.IsNull(i) || resultArr.Value(i) != v.(bool) {
+					t.Errorf("element %d: expected %v, got %s", i, v, resultArr)
+				}
+			}
+		})
+	}
+
+	t.Run("not", func(t *testing.T) {
+		result, err := Not(right)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		resultArr := result.(*array.Boolean)
+		if resultArr.Value(0) || !resultArr.Value(1) || !resultArr.IsNull(2) {
+			t.Errorf("expected [false true null ...], got %s", resultArr)
+		}
+	})
+
+	t.Run("invalid masks", func(t *testing.T) {
+		short := newMask(true)
+		defer short.Release()
+		if _, err := And(left, short); err == nil {
+			t.Errorf("expected length mismatch error, got nil")
+		}
+
+		ints := FromSlice("test_int64", []int64{1})
+		defer ints.Release()
+		if _, err := Or(short, ints.array); err == nil {
+			t.Errorf("expected non-boolean mask error, got nil")
+		}
+	})
+
+	t.Run("filters a DataFrame condition", func(t *testing.T) {
+		age := FromSlice("age", []int64{25, 35, 45})
+		defer age.Release()
+		country := FromSlice("country", []string{"JP", "JP", "US"})
+		defer country.Release()
+
+		older, err := age.Comparison(utils.Greater, 30)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer older.Release()
+		japanese, err := country.Comparison(utils.Equal, "JP")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer japanese.Release()
+
+		mask, err := And(older, japanese)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer mask.Release()
+
+		if n, err := CountTrue(mask); err != nil || n != 1 {
+			t.Errorf("expected 1 row, got %d (%v)", n, err)
+		}
+	})
+}
+
+func TestMask_Reductions(t *testing.T) {
+	tests := []struct {
+		name      string
+		mask      ComparisonArray
+		any       bool
+		all       bool
+		countTrue int
+	}{
+		{"mixed", newMask(true, false, nil, true), true, false, 2},
+		{"true and null", newMask(true, nil), true, true, 1},
+		{"only null", newMask(nil, nil), false, true, 0},
+		{"empty", newMask(), false, true, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer tt.mask.Release()
+
+			anyTrue, err := Any(tt.mask)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			allTrue, err := All(tt.mask)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			countTrue, err := CountTrue(tt.mask)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if anyTrue != tt.any || allTrue != tt.all || countTrue != tt.countTrue {
+				t.Errorf("expected any %v, all %v and %d true, got %v, %v and %d", tt.any, tt.all, tt.countTrue, anyTrue, allTrue, countTrue)
+			}
+		})
+	}
+
+	t.Run("sliced mask", func(t *testing.T) {
+		mask := newMask(true, true, false, nil, true)
+		defer mask.Release()
+
+		sliced := array.NewSlice(mask, 1, 4)
+		defer sliced.Release()
+
+		if n, err := CountTrue(sliced); err != nil || n != 1 {
+			t.Errorf("expected 1 true element, got %d (%v)", n, err)
+		}
+	})
+
+	t.Run("non-boolean mask", func(t *testing.T) {
+		ints := FromSlice("test_int64", []int64{1})
+		defer ints.Release()
+
+		if _, err := Any(ints.array); err == nil {
+			t.Errorf("expected non-boolean mask error, got nil")
+		}
+	})
+}
@@ -0,0 +1,225 @@
+package series
+
+import (
+	"context"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/scalar"
+
+	"github.com/SHIMA0111/gleam/gleam/utils"
+	"github.com/SHIMA0111/gleam/internal/compute/array"
+)
+
+// Abs returns the absolute values of a numeric Series, of the type of the Series. Nulls propagate.
+// Returns an error if the Series is not numeric or holds the minimum value of an integer type, which has no
+// absolute value in the type.
+func (s *Series) Abs() (*Series, error) {
+	return s.AbsCtx(context.Background())
+}
+
+// AbsCtx is Abs with a caller-provided context.
+func (s *Series) AbsCtx(ctx context.Context) (*Series, error) {
+	return s.math(ctx, array.MathAbs)
+}
+
+// Sign returns -1, 0 or 1 by the sign of the elements of a numeric Series: Int8 for an integer Series, and the
+// float type of a float Series, whose NaN values stay NaN. Nulls propagate.
+func (s *Series) Sign() (*Series, error) {
+	return s.SignCtx(context.Background())
+}
+
+// SignCtx is Sign with a caller-provided context.
+func (s *Series) SignCtx(ctx context.Context) (*Series, error) {
+	return s.math(ctx, array.MathSign)
+}
+
+// Round rounds the elements of a numeric Series to the number of decimal places with the rounding mode, where a
+// negative number rounds to a power of ten, e.g. -2 to hundreds. The result has the type of the Series, and nulls
+// propagate. An integer Series is rounded exactly, and is unchanged by a non-negative number of decimal places.
+// Returns an error if the Series is not numeric, or if a rounded integer does not fit in the type of the Series.
+func (s *Series) Round(decimals int32, mode utils.RoundingMode) (*Series, error) {
+	return s.RoundCtx(context.Background(), decimals, mode)
+}
+
+// RoundCtx is Round with a caller-provided context.
+func (s *Series) RoundCtx(ctx context.Context, decimals int32, mode utils.RoundingMode) (*Series, error) {
+	return s.mapChunks(func(chunk arrow.Array) (arrow.Array, error) {
+		return array.Round(ctx, chunk, decimals, mode, s.mem)
+	})
+}
+
+// Floor rounds the elements of a numeric Series towards negative infinity. The result has the type of the Series,
+// so an integer Series is unchanged. Nulls propagate.
+func (s *Series) Floor() (*Series, error) {
+	return s.FloorCtx(context.Background())
+}
+
+// FloorCtx is Floor with a caller-provided context.
+func (s *Series) FloorCtx(ctx context.Context) (*Series, error) {
+	return s.math(ctx, array.MathFloor)
+}
+
+// Ceil rounds the elements of a numeric Series towards positive infinity, see Floor.
+func (s *Series) Ceil() (*Series, error) {
+	return s.CeilCtx(context.Background())
+}
+
+// CeilCtx is Ceil with a caller-provided context.
+func (s *Series) CeilCtx(ctx context.Context) (*Series, error) {
+	return s.math(ctx, array.MathCeil)
+}
+
+// Sqrt returns the square roots of the elements of a numeric Series. Like the other floating point functions,
+// it returns Float32 for a Float32 Series and Float64 for any other numeric Series, gives NaN for a value outside
+// its domain, e.g. a negative value, and propagates nulls.
+func (s *Series) Sqrt() (*Series, error) {
+	return s.SqrtCtx(context.Background())
+}
+
+// SqrtCtx is Sqrt with a caller-provided context.
+func (s *Series) SqrtCtx(ctx context.Context) (*Series, error) {
+	return s.math(ctx, array.MathSqrt)
+}
+
+// Exp returns e raised to the power of the elements of a numeric Series, see Sqrt.
+func (s *Series) Exp() (*Series, error) {
+	return s.ExpCtx(context.Background())
+}
+
+// ExpCtx is Exp with a caller-provided context.
+func (s *Series) ExpCtx(ctx context.Context) (*Series, error) {
+	return s.math(ctx, array.MathExp)
+}
+
+// Log returns the natural logarithms of the elements of a numeric Series, see Sqrt.
+// The logarithm of 0 is negative infinity.
+func (s *Series) Log() (*Series, error) {
+	return s.LogCtx(context.Background())
+}
+
+// LogCtx is Log with a caller-provided context.
+func (s *Series) LogCtx(ctx context.Context) (*Series, error) {
+	return s.math(ctx, array.MathLog)
+}
+
+// Log10 returns the base 10 logarithms of the elements of a numeric Series, see Log.
+func (s *Series) Log10() (*Series, error) {
+	return s.Log10Ctx(context.Background())
+}
+
+// Log10Ctx is Log10 with a caller-provided context.
+func (s *Series) Log10Ctx(ctx context.Context) (*Series, error) {
+	return s.math(ctx, array.MathLog10)
+}
+
+// Log1p returns the natural logarithms of 1 plus the elements of a numeric Series, which is accurate
+// for elements near 0, see Log.
+func (s *Series) Log1p() (*Series, error) {
+	return s.Log1pCtx(context.Background())
+}
+
+// Log1pCtx is Log1p with a caller-provided context.
+func (s *Series) Log1pCtx(ctx context.Context) (*Series, error) {
+	return s.math(ctx, array.MathLog1p)
+}
+
+// Sin returns the sines of the elements of a numeric Series in radians, see Sqrt.
+func (s *Series) Sin() (*Series, error) {
+	return s.SinCtx(context.Background())
+}
+
+// SinCtx is Sin with a caller-provided context.
+func (s *Series) SinCtx(ctx context.Context) (*Series, error) {
+	return s.math(ctx, array.MathSin)
+}
+
+// Cos returns the cosines of the elements of a numeric Series in radians, see Sqrt.
+func (s *Series) Cos() (*Series, error) {
+	return s.CosCtx(context.Background())
+}
+
+// CosCtx is Cos with a caller-provided context.
+func (s *Series) CosCtx(ctx context.Context) (*Series, error) {
+	return s.math(ctx, array.MathCos)
+}
+
+// Tan returns the tangents of the elements of a numeric Series in radians, see Sqrt.
+func (s *Series) Tan() (*Series, error) {
+	return s.TanCtx(context.Background())
+}
+
+// TanCtx is Tan with a caller-provided context.
+func (s *Series) TanCtx(ctx context.Context) (*Series, error) {
+	return s.math(ctx, array.MathTan)
+}
+
+// Asin returns the arcsines of the elements of a numeric Series in radians, see Sqrt.
+// An element outside [-1, 1] gives NaN.
+func (s *Series) Asin() (*Series, error) {
+	return s.AsinCtx(context.Background())
+}
+
+// AsinCtx is Asin with a caller-provided context.
+func (s *Series) AsinCtx(ctx context.Context) (*Series, error) {
+	return s.math(ctx, array.MathAsin)
+}
+
+// Acos returns the arccosines of the elements of a numeric Series in radians, see Asin.
+func (s *Series) Acos() (*Series, error) {
+	return s.AcosCtx(context.Background())
+}
+
+// AcosCtx is Acos with a caller-provided context.
+func (s *Series) AcosCtx(ctx context.Context) (*Series, error) {
+	return s.math(ctx, array.MathAcos)
+}
+
+// Atan returns the arctangents of the elements of a numeric Series in radians, see Sqrt.
+func (s *Series) Atan() (*Series, error) {
+	return s.AtanCtx(context.Background())
+}
+
+// AtanCtx is Atan with a caller-provided context.
+func (s *Series) AtanCtx(ctx context.Context) (*Series, error) {
+	return s.math(ctx, array.MathAtan)
+}
+
+// Clip limits the elements of a numeric Series to the range from lo to hi. A bound is a Go number that fits in
+// the type of the Series, see makeScalar, or nil to leave that side unbounded. The result has the type of the
+// Series, and nulls and NaN values propagate.
+// Returns an error if the Series is not numeric, if a bound does not fit or is NaN, or if lo is greater than hi.
+func (s *Series) Clip(lo, hi interface{}) (*Series, error) {
+	return s.ClipCtx(context.Background(), lo, hi)
+}
+
+// ClipCtx is Clip with a caller-provided context.
+func (s *Series) ClipCtx(ctx context.Context, lo, hi interface{}) (*Series, error) {
+	loScalar, err := clipBound(lo)
+	if err != nil {
+		return nil, err
+	}
+	hiScalar, err := clipBound(hi)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.mapChunks(func(chunk arrow.Array) (arrow.Array, error) {
+		return array.Clip(ctx, chunk, loScalar, hiScalar, s.mem)
+	})
+}
+
+// clipBound returns the scalar of a Clip bound, or nil for an unbounded side.
+func clipBound(bound interface{}) (scalar.Scalar, error) {
+	if bound == nil {
+		return nil, nil
+	}
+
+	return makeScalar(bound)
+}
+
+// math applies the math function to every chunk of the Series.
+func (s *Series) math(ctx context.Context, fn array.MathFunc) (*Series, error) {
+	return s.mapChunks(func(chunk arrow.Array) (arrow.Array, error) {
+		return array.Math(ctx, fn, chunk, s.mem)
+	})
+}
@@ -0,0 +1,273 @@
+package series
+
+import (
+	"math"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+
+	"github.com/SHIMA0111/gleam/gleam/utils"
+)
+
+func TestSeries_Math(t *testing.T) {
+	t.Run("abs and sign keep integers", func(t *testing.T) {
+		s, err := FromSliceWithValidity("test_int32", []int32{-3, 0, 5}, []bool{true, false, true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer s.Release()
+
+		abs, err := s.Abs()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer abs.Release()
+
+		absArr := abs.array.(*array.Int32)
+		if absArr.Value(0) != 3 || !absArr.IsNull(1) || absArr.Value(2) != 5 {
+			t.Errorf("expected [3 null 5], got %s", abs)
+		}
+
+		sign, err := s.Sign()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer sign.Release()
+
+		signArr := sign.array.(*array.Int8)
+		if signArr.Value(0) != -1 || !signArr.IsNull(1) || signArr.Value(2) != 1 {
+			t.Errorf("expected [-1 null 1], got %s", sign)
+		}
+
+		minimum := FromSlice("test_int8", []int8{math.MinInt8})
+		defer minimum.Release()
+		if _, err := minimum.Abs(); err == nil {
+			t.Errorf("expected overflow error, got nil")
+		}
+	})
+
+	t.Run("floating point functions", func(t *testing.T) {
+		s := newChunkedInt64(t, []int64{4, 0}, []int64{-1})
+		defer s.Release()
+
+		sqrt, err := s.Sqrt()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer sqrt.Release()
+
+		values, valid, err := Values[float64](sqrt)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if sqrt.NumChunks() != 2 || values[0] != 2 || valid[1] || !math.IsNaN(values[2]) {
+			t.Errorf("expected [2 null NaN] in 2 chunks, got %s", sqrt)
+		}
+
+		floats := FromSlice("test_float32", []float32{0, 1})
+		defer floats.Release()
+
+		exp, err := floats.Exp()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer exp.Release()
+
+		expArr := exp.array.(*array.Float32)
+		if expArr.Value(0) != 1 || expArr.Value(1) != float32(math.E) {
+			t.Errorf("expected float32 [1 e], got %s", exp)
+		}
+
+		log, err := floats.Log()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer log.Release()
+
+		logArr := log.array.(*array.Float32)
+		if !math.IsInf(float64(logArr.Value(0)), -1) || logArr.Value(1) != 0 {
+			t.Errorf("expected float32 [-Inf 0], got %s", log)
+		}
+
+		asin, err := s.Asin()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer asin.Release()
+
+		values, _, err = Values[float64](asin)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !math.IsNaN(values[0]) || values[2] != -math.Pi/2 {
+			t.Errorf("expected [NaN null -pi/2], got %s", asin)
+		}
+	})
+
+	t.Run("floor and ceil", func(t *testing.T) {
+		s := FromSlice("test_float64", []float64{-1.5, 2.5})
+		defer s.Release()
+
+		floor, err := s.Floor()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer floor.Release()
+
+		ceil, err := s.Ceil()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer ceil.Release()
+
+		floorArr, ceilArr := floor.array.(*array.Float64), ceil.array.(*array.Float64)
+		if floorArr.Value(0) != -2 || floorArr.Value(1) != 2 || ceilArr.Value(0) != -1 || ceilArr.Value(1) != 3 {
+			t.Errorf("expected [-2 2] and [-1 3], got %s and %s", floor, ceil)
+		}
+
+		ints := FromSlice("test_uint8", []uint8{7})
+		defer ints.Release()
+
+		intFloor, err := ints.Floor()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer intFloor.Release()
+
+		if !arrow.TypeEqual(intFloor.DType(), arrow.PrimitiveTypes.Uint8) {
+			t.Errorf("expected uint8, got %s", intFloor.DType())
+		}
+	})
+
+	t.Run("round", func(t *testing.T) {
+		s := FromSlice("test_float64", []float64{2.5, -2.5, 1.25})
+		defer s.Release()
+
+		halfEven, err := s.Round(0, utils.RoundHalfEven)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer halfEven.Release()
+
+		halfEvenArr := halfEven.array.(*array.Float64)
+		if halfEvenArr.Value(0) != 2 || halfEvenArr.Value(1) != -2 || halfEvenArr.Value(2) != 1 {
+			t.Errorf("expected [2 -2 1], got %s", halfEven)
+		}
+
+		halfUp, err := s.Round(1, utils.RoundHalfUp)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer halfUp.Release()
+
+		if v := halfUp.array.(*array.Float64).Value(2); v != 1.3 {
+			t.Errorf("expected 1.3, got %v", v)
+		}
+
+		ints := FromSlice("test_int16", []int16{150, -250, 32700})
+		defer ints.Release()
+
+		hundreds, err := ints.Round(-2, utils.RoundHalfEven)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer hundreds.Release()
+
+		hundredsArr := hundreds.array.(*array.Int16)
+		if hundredsArr.Value(0) != 200 || hundredsArr.Value(1) != -200 || hundredsArr.Value(2) != 32700 {
+			t.Errorf("expected int16 [200 -200 32700], got %s", hundreds)
+		}
+
+		if _, err := ints.Round(-3, utils.RoundUp); err == nil {
+			t.Errorf("expected overflow error, got nil")
+		}
+	})
+
+	t.Run("clip", func(t *testing.T) {
+		s, err := FromSliceWithValidity("test_int64", []int64{-5, 0, 5, 10}, []bool{true, false, true, true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer s.Release()
+
+		clipped, err := s.Clip(0, 6)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer clipped.Release()
+
+		clippedArr := clipped.array.(*array.Int64)
+		if clippedArr.Value(0) != 0 || !clippedArr.IsNull(1) || clippedArr.Value(2) != 5 || clippedArr.Value(3) != 6 {
+			t.Errorf("expected [0 null 5 6], got %s", clipped)
+		}
+
+		upper, err := s.Clip(nil, 1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer upper.Release()
+
+		if v := upper.array.(*array.Int64).Value(0); v != -5 {
+			t.Errorf("expected the unbounded lower side to keep -5, got %d", v)
+		}
+
+		if _, err := s.Clip(6, 0); err == nil {
+			t.Errorf("expected bound order error, got nil")
+		}
+		if _, err := s.Clip(0.5, nil); err == nil {
+			t.Errorf("expected bound type error, got nil")
+		}
+
+		floats := FromSlice("test_float32", []float32{float32(math.NaN()), 3})
+		defer floats.Release()
+
+		clippedFloats, err := floats.Clip(nil, 2)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer clippedFloats.Release()
+
+		floatArr := clippedFloats.array.(*array.Float32)
+		if !math.IsNaN(float64(floatArr.Value(0))) || floatArr.Value(1) != 2 {
+			t.Errorf("expected float32 [NaN 2], got %s", clippedFloats)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		s := FromSlice("test_int64", []int64{})
+		defer s.Release()
+
+		for name, fn := range map[string]func() (*Series, error){
+			"round": func() (*Series, error) { return s.Round(-1, utils.RoundHalfEven) },
+			"clip":  func() (*Series, error) { return s.Clip(0, 1) },
+			"exp":   s.Exp,
+			"sqrt":  s.Sqrt,
+			"abs":   s.Abs,
+		} {
+			result, err := fn()
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", name, err)
+			}
+			if result.Len() != 0 {
+				t.Errorf("%s: expected an empty result, got %s", name, result)
+			}
+			result.Release()
+		}
+	})
+
+	t.Run("non-numeric", func(t *testing.T) {
+		s := FromSlice("test_string", []string{"a"})
+		defer s.Release()
+
+		if _, err := s.Sqrt(); err == nil {
+			t.Errorf("expected unsupported type error, got nil")
+		}
+		if _, err := s.Round(0, utils.RoundHalfEven); err == nil {
+			t.Errorf("expected unsupported type error, got nil")
+		}
+		if _, err := s.Clip(0, 1); err == nil {
+			t.Errorf("expected unsupported type error, got nil")
+		}
+	})
+}
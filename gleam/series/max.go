@@ -2,17 +2,61 @@ package series
 
 import (
 	"context"
+	"fmt"
+
 	"github.com/SHIMA0111/gleam/internal/compute/array"
+	"github.com/apache/arrow-go/v18/arrow/scalar"
 )
 
-func (s *Series) Max() (*Series, error) {
-	ctx := context.Background()
+// Max returns the largest non-null element of the Series as a one-row Series of the same data type.
+// Booleans are largest when true (any), strings compare lexicographically, temporal values chronologically
+// and decimals by their exact value.
+// NaN values of a float Series are handled by the NaN policy (see WithNaNPolicy). If there is no value left,
+// because every element is null or a skipped NaN, the result is a null element.
+func (s *Series) Max(opts ...AggregateOption) (*Series, error) {
+	return s.MaxCtx(context.Background(), opts...)
+}
+
+// MaxCtx is Max with a caller-provided context.
+func (s *Series) MaxCtx(ctx context.Context, opts ...AggregateOption) (*Series, error) {
+	scl, err := s.max(ctx, newAggregateOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.scalarSeries(scl)
+}
+
+// MaxValue is Max returning the maximum as a Scalar instead of a one-row Series.
+func (s *Series) MaxValue(opts ...AggregateOption) (Scalar, error) {
+	return s.MaxValueCtx(context.Background(), opts...)
+}
+
+// MaxValueCtx is MaxValue with a caller-provided context.
+func (s *Series) MaxValueCtx(ctx context.Context, opts ...AggregateOption) (Scalar, error) {
+	scl, err := s.max(ctx, newAggregateOptions(opts))
+	if err != nil {
+		return Scalar{}, err
+	}
+
+	return Scalar{value: scl}, nil
+}
+
+func (s *Series) max(ctx context.Context, options aggregateOptions) (scalar.Scalar, error) {
+	if s.Len() == 0 {
+		return nil, fmt.Errorf("cannot find max value of empty Series")
+	}
 
-	newArray, err := array.MaxArray(ctx, s.array, s.mem)
+	state, err := reduceSeries(
+		ctx,
+		s,
+		s.reduceChunkSize(),
+		withNaNPolicy(options.nan, array.MaxStateOf, array.SkipNaNMaxStateOf),
+		array.MaxState.Merge,
+	)
 	if err != nil {
 		return nil, err
 	}
-	defer newArray.Release()
 
-	return NewSeries(s.name, newArray), nil
+	return state.Scalar(s.DType()), nil
 }
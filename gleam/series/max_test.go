@@ -1,9 +1,12 @@
 package series
 
 import (
+	"fmt"
+	"math"
 	"strings"
 	"testing"
 
+	"github.com/SHIMA0111/gleam/gleam/utils"
 	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
 	"github.com/apache/arrow-go/v18/arrow/memory"
@@ -393,18 +396,118 @@ func TestSeries_Max(t *testing.T) {
 		}
 	})
 
+	t.Run("boolean max", func(t *testing.T) {
+		for _, tc := range []struct {
+			values   []bool
+			valid    []bool
+			expected bool
+		}{
+			{[]bool{false, true, false}, nil, true},
+			{[]bool{false, false, true}, []bool{true, true, false}, false},
+		} {
+			builder := array.NewBooleanBuilder(mem)
+			builder.AppendValues(tc.values, tc.valid)
+			arr := builder.NewArray()
+			builder.Release()
+
+			s := NewSeries("test_bool", arr)
+			arr.Release()
+
+			result, err := s.Max()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := result.array.(*array.Boolean).Value(0); got != tc.expected {
+				t.Errorf("expected max %v of %v, got %v", tc.expected, tc.values, got)
+			}
+			result.Release()
+			s.Release()
+		}
+	})
+
+	t.Run("concurrent string max", func(t *testing.T) {
+		builder := array.NewStringBuilder(mem)
+		defer builder.Release()
+
+		// Visit every key once in a scrambled order; every 10th element is null
+		n := ConcurrentReduceThreshold + 1
+		for i := 0; i < n; i++ {
+			if i%10 == 0 {
+				builder.AppendNull()
+				continue
+			}
+			builder.Append(fmt.Sprintf("key_%07d", (i*7919)%n))
+		}
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		s := NewSeries("test_string", arr)
+		defer s.Release()
+
+		result, err := s.Max()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		expected := ""
+		for i := 0; i < n; i++ {
+			if i%10 == 0 {
+				continue
+			}
+			v := fmt.Sprintf("key_%07d", (i*7919)%n)
+			if expected == "" || v > expected {
+				expected = v
+			}
+		}
+
+		if got := result.array.(*array.String).Value(0); got != expected {
+			t.Errorf("expected max %q, got %q", expected, got)
+		}
+	})
+
+	t.Run("timestamp max keeps the time zone", func(t *testing.T) {
+		dtype := &arrow.TimestampType{Unit: arrow.Millisecond, TimeZone: "Asia/Tokyo"}
+		builder := array.NewTimestampBuilder(mem, dtype)
+		defer builder.Release()
+
+		builder.AppendValues(
+			[]arrow.Timestamp{1_700_000_000_000, 1_700_000_300_000, 0, 1_699_999_900_000},
+			[]bool{true, true, false, true},
+		)
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		s := NewSeries("test_timestamp", arr)
+		defer s.Release()
+
+		result, err := s.Max()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		if !arrow.TypeEqual(result.DType(), dtype) {
+			t.Errorf("expected type %s, got %s", dtype, result.DType())
+		}
+		if got := result.array.(*array.Timestamp).Value(0); got != 1_700_000_300_000 {
+			t.Errorf("expected max %d, got %d", int64(1_700_000_300_000), got)
+		}
+	})
+
 	// Unsupported type
 	t.Run("unsupported type", func(t *testing.T) {
-		builder := array.NewBooleanBuilder(mem)
+		builder := array.NewBinaryBuilder(mem, arrow.BinaryTypes.Binary)
 		defer builder.Release()
 
 		// Append values
-		builder.AppendValues([]bool{true, false, true}, nil)
+		builder.AppendValues([][]byte{[]byte("a"), []byte("b")}, nil)
 		arr := builder.NewArray()
 		defer arr.Release()
 
 		// Create a series
-		s := NewSeries("boolean_test", arr)
+		s := NewSeries("binary_test", arr)
 		defer s.Release()
 
 		// Calculate max - should return unsupported type error
@@ -413,8 +516,8 @@ func TestSeries_Max(t *testing.T) {
 			t.Fatalf("expected unsupported type error, got nil")
 		}
 
-		// Check that the error message mentions the boolean type
-		expectedType := arrow.FixedWidthTypes.Boolean.String()
+		// Check that the error message mentions the binary type
+		expectedType := arrow.BinaryTypes.Binary.String()
 		if !strings.Contains(err.Error(), expectedType) {
 			t.Errorf("expected error message to contain %q, got: %v", expectedType, err)
 		}
@@ -452,4 +555,147 @@ func TestSeries_Max(t *testing.T) {
 			t.Errorf("expected max 7, got %d", resultArr.Value(0))
 		}
 	})
+
+	t.Run("concurrent max with nulls", func(t *testing.T) {
+		builder := array.NewInt64Builder(mem)
+		defer builder.Release()
+
+		// Append enough values to take the concurrent path, with a null prefix longer than a chunk
+		n := ConcurrentReduceThreshold * 2
+		values := make([]int64, n)
+		valid := make([]bool, n)
+		for i := 0; i < n; i++ {
+			values[i] = int64(i)
+			valid[i] = i >= n/2 || i == 2
+		}
+
+		// Null slots with extreme placeholder values must be ignored
+		values[0] = -1_000_000
+		values[1] = 1_000_000_000
+
+		builder.AppendValues(values, valid)
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		s := NewSeries("concurrent", arr)
+		defer s.Release()
+
+		result, err := s.Max()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		resultArr := result.array.(*array.Int64)
+		if resultArr.Value(0) != int64(n-1) {
+			t.Errorf("expected max %d, got %d", int64(n-1), resultArr.Value(0))
+		}
+	})
+
+	t.Run("all null values", func(t *testing.T) {
+		builder := array.NewInt32Builder(mem)
+		defer builder.Release()
+
+		// Append only nulls
+		builder.AppendNulls(3)
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		s := NewSeries("all_nulls", arr)
+		defer s.Release()
+
+		// The max of only nulls is null
+		result, err := s.Max()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		if result.Len() != 1 || !result.IsNull(0) {
+			t.Errorf("expected a single null, got %s", result)
+		}
+	})
+
+	t.Run("nan policies", func(t *testing.T) {
+		// A leading NaN and a NaN in the middle must give the same answer
+		for _, values := range [][]float64{
+			{math.NaN(), 1, 3, 2},
+			{1, 3, math.NaN(), 2},
+		} {
+			builder := array.NewFloat64Builder(mem)
+			builder.AppendValues(values, nil)
+			arr := builder.NewArray()
+			builder.Release()
+
+			s := NewSeries("test_nan", arr)
+			arr.Release()
+
+			propagated, err := s.Max()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if v := propagated.array.(*array.Float64).Value(0); !math.IsNaN(v) {
+				t.Errorf("expected NaN with the propagate policy for %v, got %f", values, v)
+			}
+			propagated.Release()
+
+			skipped, err := s.Max(WithNaNPolicy(utils.NaNSkip))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if v := skipped.array.(*array.Float64).Value(0); v != 3 {
+				t.Errorf("expected max 3 with the skip policy for %v, got %f", values, v)
+			}
+			skipped.Release()
+
+			if _, err := s.Max(WithNaNPolicy(utils.NaNError)); err == nil {
+				t.Errorf("expected nan error for %v", values)
+			}
+			s.Release()
+		}
+	})
+
+	t.Run("decimal256 values", func(t *testing.T) {
+		values := FromSlice("test_decimal", []string{"-1.5", "-0.25", "-10"})
+		defer values.Release()
+
+		s, err := values.Cast(Decimal256, WithDecimal(50, 2))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer s.Release()
+
+		result, err := s.Max()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		if !arrow.TypeEqual(result.DType(), s.DType()) {
+			t.Errorf("expected type %s, got %s", s.DType(), result.DType())
+		}
+		if v := result.array.(*array.Decimal256).Value(0).ToString(2); v != "-0.25" {
+			t.Errorf("expected max -0.25, got %s", v)
+		}
+	})
+
+	t.Run("categorical values", func(t *testing.T) {
+		values := FromSlice("test_country", []string{"jp", "us", "de", "jp"})
+		defer values.Release()
+
+		s, err := values.Cast(Categorical)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer s.Release()
+
+		result, err := s.MaxValue()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !arrow.TypeEqual(result.DType(), s.DType()) || result.String() != "us" {
+			t.Errorf("expected the category us, got %s %s", result.DType(), result)
+		}
+	})
 }
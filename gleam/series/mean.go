@@ -3,44 +3,68 @@ package series
 import (
 	"context"
 	"fmt"
+	"github.com/SHIMA0111/gleam/gleam/utils"
 	"github.com/SHIMA0111/gleam/internal/compute/array"
-	"github.com/apache/arrow-go/v18/arrow/memory"
 	"github.com/apache/arrow-go/v18/arrow/scalar"
 )
 
-func (s *Series) Mean() (*Series, error) {
-	mean, err := s.mean(context.Background())
+// Mean calculates the arithmetic mean of the non-null elements in the Series, returning it as a one-row Float64 Series.
+// The mean of a decimal Series is exact, rounded half to even to its scale, and of the same decimal type.
+// An integer sum that does not fit in 64 bits is handled by the overflow policy (see WithOverflowPolicy),
+// float values are accumulated as selected by WithSumMode and NaN values are handled by the NaN policy
+// (see WithNaNPolicy). Skipped NaN values do not count towards the number of elements, and the mean is null
+// if no element is left.
+func (s *Series) Mean(opts ...AggregateOption) (*Series, error) {
+	return s.MeanCtx(context.Background(), opts...)
+}
+
+// MeanCtx is Mean with a caller-provided context.
+func (s *Series) MeanCtx(ctx context.Context, opts ...AggregateOption) (*Series, error) {
+	mean, err := s.mean(ctx, newAggregateOptions(opts))
 	if err != nil {
 		return nil, err
 	}
-	mem := memory.DefaultAllocator
 
-	scl := scalar.NewFloat64Scalar(mean)
-	arr, err := scalar.MakeArrayFromScalar(scl, 1, mem)
+	return s.scalarSeries(mean)
+}
+
+// MeanValue is Mean returning the mean as a Scalar instead of a one-row Series.
+func (s *Series) MeanValue(opts ...AggregateOption) (Scalar, error) {
+	return s.MeanValueCtx(context.Background(), opts...)
+}
+
+// MeanValueCtx is MeanValue with a caller-provided context.
+func (s *Series) MeanValueCtx(ctx context.Context, opts ...AggregateOption) (Scalar, error) {
+	mean, err := s.mean(ctx, newAggregateOptions(opts))
 	if err != nil {
-		return nil, err
+		return Scalar{}, err
 	}
-	defer arr.Release()
 
-	return NewSeries(s.name, arr), nil
+	return Scalar{value: mean}, nil
 }
 
-func (s *Series) mean(ctx context.Context) (float64, error) {
+func (s *Series) mean(ctx context.Context, options aggregateOptions) (scalar.Scalar, error) {
 	if s.Len() == 0 {
-		return 0, fmt.Errorf("cannot find mean value of empty Series")
+		return nil, fmt.Errorf("cannot find mean value of empty Series")
 	}
 
-	count, err := s.count()
-	if err != nil {
-		return 0, err
-	}
-	if count == 0 {
-		return 0, nil
+	chunkSize := s.reduceChunkSize()
+	stateOf, skipNaNStateOf := array.MeanStateOf, array.SkipNaNMeanStateOf
+	if options.sumMode == utils.SumPrecise {
+		chunkSize = PreciseChunkSize
+		stateOf, skipNaNStateOf = array.PreciseMeanStateOf, array.PreciseSkipNaNMeanStateOf
 	}
 
-	sumVal, err := array.Sum(ctx, s.array)
+	state, err := reduceSeries(
+		ctx,
+		s,
+		chunkSize,
+		withNaNPolicy(options.nan, stateOf, skipNaNStateOf),
+		array.MeanState.Merge,
+	)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return sumVal / float64(count), nil
+
+	return state.Scalar(options.overflow)
 }
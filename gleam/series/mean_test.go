@@ -1,9 +1,11 @@
 package series
 
 import (
+	"github.com/SHIMA0111/gleam/gleam/utils"
 	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
 	"github.com/apache/arrow-go/v18/arrow/memory"
+	"math"
 	"strings"
 	"testing"
 )
@@ -394,6 +396,38 @@ func TestSeries_Mean(t *testing.T) {
 		}
 	})
 
+	t.Run("int64 mean with overflowing sum", func(t *testing.T) {
+		builder := array.NewInt64Builder(mem)
+		defer builder.Release()
+
+		// Append values whose sum does not fit in int64
+		builder.AppendValues([]int64{math.MaxInt64, math.MaxInt64}, nil)
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		// Create a series
+		s := NewSeries("overflow_int64", arr)
+		defer s.Release()
+
+		// The default policy keeps the exact sum
+		result, err := s.Mean()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		resultArr := result.array.(*array.Float64)
+		if resultArr.Value(0) != float64(math.MaxInt64) {
+			t.Errorf("expected mean %f, got %f", float64(math.MaxInt64), resultArr.Value(0))
+		}
+
+		// The error policy rejects the overflowing sum
+		_, err = s.Mean(WithOverflowPolicy(utils.OverflowError))
+		if err == nil {
+			t.Fatalf("expected overflow error, got nil")
+		}
+	})
+
 	// Unsupported type
 	t.Run("unsupported type", func(t *testing.T) {
 		builder := array.NewBooleanBuilder(mem)
@@ -453,4 +487,140 @@ func TestSeries_Mean(t *testing.T) {
 			t.Errorf("expected mean 0.0, got %f", resultArr.Value(0))
 		}
 	})
+
+	t.Run("concurrent mean with nulls", func(t *testing.T) {
+		builder := array.NewInt64Builder(mem)
+		defer builder.Release()
+
+		// Append enough values to take the concurrent path
+		n := ConcurrentReduceThreshold * 2
+		values := make([]int64, n)
+		valid := make([]bool, n)
+		var sum, count int64
+		for i := 0; i < n; i++ {
+			values[i] = int64(i % 100)
+			valid[i] = i%7 != 0
+			if valid[i] {
+				sum += values[i]
+				count++
+			} else {
+				// Null slots with large placeholder values must be ignored
+				values[i] = 1_000_000
+			}
+		}
+
+		builder.AppendValues(values, valid)
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		s := NewSeries("concurrent", arr)
+		defer s.Release()
+
+		result, err := s.Mean()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		expected := float64(sum) / float64(count)
+		resultArr := result.array.(*array.Float64)
+		if math.Abs(resultArr.Value(0)-expected) > 1e-9 {
+			t.Errorf("expected mean %f, got %f", expected, resultArr.Value(0))
+		}
+	})
+
+	t.Run("nan skipped from sum and count", func(t *testing.T) {
+		builder := array.NewFloat64Builder(mem)
+		defer builder.Release()
+
+		builder.AppendValues([]float64{1, math.NaN(), 2, 100}, []bool{true, true, true, false})
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		s := NewSeries("test_nan", arr)
+		defer s.Release()
+
+		result, err := s.Mean(WithNaNPolicy(utils.NaNSkip))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		if v := result.array.(*array.Float64).Value(0); v != 1.5 {
+			t.Errorf("expected mean 1.5, got %f", v)
+		}
+
+		propagated, err := s.Mean()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer propagated.Release()
+
+		if v := propagated.array.(*array.Float64).Value(0); !math.IsNaN(v) {
+			t.Errorf("expected NaN with the propagate policy, got %f", v)
+		}
+	})
+
+	t.Run("all nan skipped is null", func(t *testing.T) {
+		builder := array.NewFloat64Builder(mem)
+		defer builder.Release()
+
+		builder.AppendValues([]float64{math.NaN(), math.NaN(), 0}, []bool{true, true, false})
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		s := NewSeries("test_all_nan", arr)
+		defer s.Release()
+
+		result, err := s.Mean(WithNaNPolicy(utils.NaNSkip))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		if !arrow.TypeEqual(result.DType(), arrow.PrimitiveTypes.Float64) {
+			t.Errorf("expected Float64 mean, got %s", result.DType())
+		}
+		if result.Len() != 1 || !result.IsNull(0) {
+			t.Errorf("expected a null mean, got %s", result.array)
+		}
+
+		for _, mode := range []utils.SumMode{utils.SumFast, utils.SumPrecise} {
+			mean, err := s.MeanValue(WithNaNPolicy(utils.NaNSkip), WithSumMode(mode))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !mean.IsNull() {
+				t.Errorf("expected a null mean with sum mode %v, got %s", mode, mean)
+			}
+		}
+	})
+
+	t.Run("decimal mean rounds half to even", func(t *testing.T) {
+		prices, err := FromSliceWithValidity("test_price", []string{"0.01", "0.02", "", "0.02", "0.02"}, []bool{true, true, false, true, true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer prices.Release()
+
+		s, err := prices.Cast(Decimal128, WithDecimal(6, 2))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer s.Release()
+
+		// 0.07 / 4 = 0.0175 rounds to the even 0.02
+		result, err := s.Mean()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		if !arrow.TypeEqual(result.DType(), s.DType()) {
+			t.Errorf("expected type %s, got %s", s.DType(), result.DType())
+		}
+		if v := result.array.(*array.Decimal128).Value(0).ToString(2); v != "0.02" {
+			t.Errorf("expected mean 0.02, got %s", v)
+		}
+	})
 }
@@ -2,17 +2,61 @@ package series
 
 import (
 	"context"
+	"fmt"
+
 	"github.com/SHIMA0111/gleam/internal/compute/array"
+	"github.com/apache/arrow-go/v18/arrow/scalar"
 )
 
-func (s *Series) Min() (*Series, error) {
-	ctx := context.Background()
+// Min returns the smallest non-null element of the Series as a one-row Series of the same data type.
+// Booleans are smallest when false (all), strings compare lexicographically, temporal values chronologically
+// and decimals by their exact value.
+// NaN values of a float Series are handled by the NaN policy (see WithNaNPolicy). If there is no value left,
+// because every element is null or a skipped NaN, the result is a null element.
+func (s *Series) Min(opts ...AggregateOption) (*Series, error) {
+	return s.MinCtx(context.Background(), opts...)
+}
+
+// MinCtx is Min with a caller-provided context.
+func (s *Series) MinCtx(ctx context.Context, opts ...AggregateOption) (*Series, error) {
+	scl, err := s.min(ctx, newAggregateOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.scalarSeries(scl)
+}
+
+// MinValue is Min returning the minimum as a Scalar instead of a one-row Series.
+func (s *Series) MinValue(opts ...AggregateOption) (Scalar, error) {
+	return s.MinValueCtx(context.Background(), opts...)
+}
+
+// MinValueCtx is MinValue with a caller-provided context.
+func (s *Series) MinValueCtx(ctx context.Context, opts ...AggregateOption) (Scalar, error) {
+	scl, err := s.min(ctx, newAggregateOptions(opts))
+	if err != nil {
+		return Scalar{}, err
+	}
+
+	return Scalar{value: scl}, nil
+}
+
+func (s *Series) min(ctx context.Context, options aggregateOptions) (scalar.Scalar, error) {
+	if s.Len() == 0 {
+		return nil, fmt.Errorf("cannot find min value of empty Series")
+	}
 
-	newArray, err := array.MinArray(ctx, s.array, s.mem)
+	state, err := reduceSeries(
+		ctx,
+		s,
+		s.reduceChunkSize(),
+		withNaNPolicy(options.nan, array.MinStateOf, array.SkipNaNMinStateOf),
+		array.MinState.Merge,
+	)
 	if err != nil {
 		return nil, err
 	}
-	defer newArray.Release()
 
-	return NewSeries(s.name, newArray), nil
+	return state.Scalar(s.DType()), nil
 }
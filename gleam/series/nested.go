@@ -0,0 +1,182 @@
+package series
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+
+	internalCompute "github.com/SHIMA0111/gleam/internal/compute/array"
+)
+
+// ListSeries gives access to the lists of a List Series, see Series.List.
+type ListSeries struct {
+	series *Series
+	array  array.ListLike
+}
+
+// List returns the list accessor of a List, LargeList or FixedSizeList Series.
+// Returns an error if the Series holds another data type or has several chunks.
+func (s *Series) List() (*ListSeries, error) {
+	chunk, err := s.singleChunk("access lists of")
+	if err != nil {
+		return nil, err
+	}
+
+	listArr, ok := chunk.(array.ListLike)
+	if !ok || !internalCompute.IsList(s.DType()) {
+		return nil, fmt.Errorf("cannot access %s Series as lists", s.DType())
+	}
+
+	return &ListSeries{series: s, array: listArr}, nil
+}
+
+// Len returns the number of elements of every list as an Int64 Series. A null list has a null length.
+func (l *ListSeries) Len() (*Series, error) {
+	return l.LenCtx(context.Background())
+}
+
+// LenCtx is Len with a caller-provided context.
+func (l *ListSeries) LenCtx(ctx context.Context) (*Series, error) {
+	return l.newSeries(internalCompute.ListLengths(ctx, l.array, l.series.mem))
+}
+
+// Get returns the element at index i of every list as a Series of the element type.
+// A negative index counts from the end of the list, e.g. -1 is the last element.
+// The element is null if the list is null or has no element at the index.
+func (l *ListSeries) Get(i int) (*Series, error) {
+	return l.GetCtx(context.Background(), i)
+}
+
+// GetCtx is Get with a caller-provided context.
+func (l *ListSeries) GetCtx(ctx context.Context, i int) (*Series, error) {
+	return l.newSeries(internalCompute.ListGet(ctx, l.array, i, l.series.mem))
+}
+
+// Contains reports for every list whether one of its elements equals the value, as a Boolean Series.
+// The value is translated as by Comparison on a Series of the element type. A null list gives a null element.
+func (l *ListSeries) Contains(val interface{}) (*Series, error) {
+	return l.ContainsCtx(context.Background(), val)
+}
+
+// ContainsCtx is Contains with a caller-provided context.
+func (l *ListSeries) ContainsCtx(ctx context.Context, val interface{}) (*Series, error) {
+	scl, err := makeScalarFor(val, l.array.ListValues().DataType())
+	if err != nil {
+		return nil, err
+	}
+
+	return l.newSeries(internalCompute.ListContains(ctx, l.array, scl, l.series.mem))
+}
+
+// Explode returns the elements of all lists in order as one Series of the element type.
+// A null or empty list becomes one null element, so that no list disappears.
+// If no list is null or empty, the result shares the memory of the List Series.
+func (l *ListSeries) Explode() (*Series, error) {
+	return l.ExplodeCtx(context.Background())
+}
+
+// ExplodeCtx is Explode with a caller-provided context.
+func (l *ListSeries) ExplodeCtx(ctx context.Context) (*Series, error) {
+	return l.newSeries(internalCompute.ListExplode(ctx, l.array, l.series.mem))
+}
+
+// Values returns the elements of all lists, without the nulls and empty lists of Explode, as a Series of
+// the element type that shares the memory of the List Series.
+func (l *ListSeries) Values() *Series {
+	return l.withArray(internalCompute.ListValues(l.array))
+}
+
+func (l *ListSeries) newSeries(arr arrow.Array, err error) (*Series, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return l.withArray(arr), nil
+}
+
+// withArray returns a Series with the name and allocator of the List Series that takes over the array reference.
+func (l *ListSeries) withArray(arr arrow.Array) *Series {
+	defer arr.Release()
+
+	return NewSeriesWithAllocator(l.series.name, arr, l.series.mem)
+}
+
+// StructSeries gives access to the fields of a Struct Series, see Series.Struct.
+type StructSeries struct {
+	series *Series
+	array  *array.Struct
+}
+
+// Struct returns the field accessor of a Struct Series.
+// Returns an error if the Series holds another data type or has several chunks.
+func (s *Series) Struct() (*StructSeries, error) {
+	chunk, err := s.singleChunk("access structs of")
+	if err != nil {
+		return nil, err
+	}
+
+	structArr, ok := chunk.(*array.Struct)
+	if !ok {
+		return nil, fmt.Errorf("cannot access %s Series as structs", s.DType())
+	}
+
+	return &StructSeries{series: s, array: structArr}, nil
+}
+
+// FieldNames returns the names of the fields in order.
+func (st *StructSeries) FieldNames() []string {
+	fields := st.array.DataType().(*arrow.StructType).Fields()
+	names := make([]string, len(fields))
+	for i, field := range fields {
+		names[i] = field.Name
+	}
+
+	return names
+}
+
+// Field returns the named field as a Series with the field name. The field shares the memory of the Struct Series;
+// it is null where the struct is null. Returns an error if there is no such field.
+func (st *StructSeries) Field(name string) (*Series, error) {
+	field, err := internalCompute.StructField(st.array, name, st.series.mem)
+	if err != nil {
+		return nil, err
+	}
+	defer field.Release()
+
+	return NewSeriesWithAllocator(name, field, st.series.mem), nil
+}
+
+// NewStructSeries creates a Struct Series whose fields are the given Series, named after them.
+// The fields share the memory of the given Series. Returns an error if the Series differ in length
+// or one of them has several chunks.
+func NewStructSeries(name string, fields ...*Series) (*Series, error) {
+	columns := make([]arrow.Array, len(fields))
+	names := make([]string, len(fields))
+	for i, field := range fields {
+		if field.Len() != fields[0].Len() {
+			return nil, fmt.Errorf("field %q has length %d, expected %d", field.Name(), field.Len(), fields[0].Len())
+		}
+		column, err := field.singleChunk("use as a field")
+		if err != nil {
+			return nil, err
+		}
+		columns[i] = column
+		names[i] = field.Name()
+	}
+
+	structArr, err := array.NewStructArray(columns, names)
+	if err != nil {
+		return nil, err
+	}
+	defer structArr.Release()
+
+	mem := memory.DefaultAllocator
+	if len(fields) > 0 {
+		mem = fields[0].mem
+	}
+
+	return NewSeriesWithAllocator(name, structArr, mem), nil
+}
@@ -0,0 +1,270 @@
+package series
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+)
+
+func TestSeries_List(t *testing.T) {
+	t.Run("len, get and contains", func(t *testing.T) {
+		s := FromListSlice("test_tags", [][]string{{"a", "b"}, nil, {}, {"c"}})
+		defer s.Release()
+
+		lists, err := s.List()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		lengths, err := lists.Len()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer lengths.Release()
+
+		lengthArr := lengths.array.(*array.Int64)
+		if lengthArr.Value(0) != 2 || !lengthArr.IsNull(1) || lengthArr.Value(2) != 0 || lengthArr.Value(3) != 1 {
+			t.Errorf("expected lengths [2 null 0 1], got %s", lengthArr)
+		}
+
+		last, err := lists.Get(-1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer last.Release()
+
+		lastArr := last.array.(*array.String)
+		if lastArr.Value(0) != "b" || !lastArr.IsNull(1) || !lastArr.IsNull(2) || lastArr.Value(3) != "c" {
+			t.Errorf("expected last elements [b null null c], got %s", lastArr)
+		}
+
+		contains, err := lists.Contains("c")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer contains.Release()
+
+		containsArr := contains.array.(*array.Boolean)
+		if containsArr.Value(0) || !containsArr.IsNull(1) || containsArr.Value(2) || !containsArr.Value(3) {
+			t.Errorf("expected contains [false null false true], got %s", containsArr)
+		}
+	})
+
+	t.Run("explode", func(t *testing.T) {
+		s := FromListSlice("test_scores", [][]int64{{1, 2}, {}, {3}})
+		defer s.Release()
+
+		lists, err := s.List()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		exploded, err := lists.Explode()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer exploded.Release()
+
+		explodedArr := exploded.array.(*array.Int64)
+		if explodedArr.Len() != 4 || explodedArr.Value(0) != 1 || explodedArr.Value(1) != 2 ||
+			!explodedArr.IsNull(2) || explodedArr.Value(3) != 3 {
+			t.Errorf("expected [1 2 null 3], got %s", explodedArr)
+		}
+
+		values := lists.Values()
+		defer values.Release()
+
+		if values.Len() != 3 || values.NullCount() != 0 {
+			t.Errorf("expected the 3 list values, got %s", values)
+		}
+	})
+
+	t.Run("explode slice without empty lists shares memory", func(t *testing.T) {
+		s := FromListSlice("test_scores", [][]int64{{1}, {2, 3}, {4}})
+		defer s.Release()
+
+		slicedArr := array.NewSlice(s.array, 1, 3)
+		defer slicedArr.Release()
+
+		sliced := NewSeries("sliced", slicedArr)
+		defer sliced.Release()
+
+		lists, err := sliced.List()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		exploded, err := lists.Explode()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer exploded.Release()
+
+		values, _, err := Values[int64](exploded)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(values) != 3 || values[0] != 2 || values[2] != 4 {
+			t.Errorf("expected [2 3 4], got %v", values)
+		}
+
+		listValues := s.array.(*array.List).ListValues().Data().Buffers()[1]
+		if exploded.array.Data().Buffers()[1] != listValues {
+			t.Errorf("expected the exploded Series to share the list values buffer")
+		}
+	})
+
+	t.Run("get and explode categorical elements", func(t *testing.T) {
+		values := FromSlice("test_values", []string{"a", "b", "a", "c"})
+		defer values.Release()
+
+		categories, err := values.Cast(Categorical)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer categories.Release()
+
+		// Lists [a b], [] and [a c] of categorical elements
+		offsets := memory.NewBufferBytes(arrow.Int32Traits.CastToBytes([]int32{0, 2, 2, 4}))
+		listData := array.NewData(arrow.ListOf(categories.DType()), 3, []*memory.Buffer{nil, offsets},
+			[]arrow.ArrayData{categories.array.Data()}, 0, 0)
+		defer listData.Release()
+		listArr := array.NewListData(listData)
+		defer listArr.Release()
+
+		s := NewSeries("test_categorical_lists", listArr)
+		defer s.Release()
+
+		lists, err := s.List()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		last, err := lists.Get(-1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer last.Release()
+
+		lastArr, ok := last.array.(*array.Dictionary)
+		if !ok || lastArr.ValueStr(0) != "b" || !lastArr.IsNull(1) || lastArr.ValueStr(2) != "c" {
+			t.Errorf("expected categorical [b null c], got %s", last)
+		}
+
+		exploded, err := lists.Explode()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer exploded.Release()
+
+		explodedArr, ok := exploded.array.(*array.Dictionary)
+		if !ok || explodedArr.Len() != 5 || explodedArr.ValueStr(1) != "b" || !explodedArr.IsNull(2) ||
+			explodedArr.ValueStr(4) != "c" {
+			t.Errorf("expected categorical [a b null a c], got %s", exploded)
+		}
+	})
+
+	t.Run("cast element type", func(t *testing.T) {
+		s := FromListSlice("test_scores", [][]int32{{1, 2}, nil})
+		defer s.Release()
+
+		result, err := s.Cast(List, WithElementType(Float64))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		if !arrow.TypeEqual(result.DType(), arrow.ListOf(arrow.PrimitiveTypes.Float64)) {
+			t.Errorf("expected list<float64>, got %s", result.DType())
+		}
+
+		if _, err := s.Cast(List); err == nil {
+			t.Errorf("expected error for a List cast without an element type, got nil")
+		}
+	})
+
+	t.Run("not a list", func(t *testing.T) {
+		s := FromSlice("test_int64", []int64{1})
+		defer s.Release()
+
+		if _, err := s.List(); err == nil || !strings.Contains(err.Error(), "as lists") {
+			t.Errorf("expected list access error, got %v", err)
+		}
+	})
+}
+
+func TestSeries_Struct(t *testing.T) {
+	t.Run("fields", func(t *testing.T) {
+		ids := FromSlice("id", []int64{1, 2, 3})
+		defer ids.Release()
+		names := FromSlice("name", []string{"a", "b", "c"})
+		defer names.Release()
+
+		s, err := NewStructSeries("test_event", ids, names)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer s.Release()
+
+		structs, err := s.Struct()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if fieldNames := structs.FieldNames(); len(fieldNames) != 2 || fieldNames[0] != "id" || fieldNames[1] != "name" {
+			t.Errorf("expected fields [id name], got %v", fieldNames)
+		}
+
+		name, err := structs.Field("name")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer name.Release()
+
+		if name.Name() != "name" || name.array.(*array.String).Value(2) != "c" {
+			t.Errorf("expected the name field, got %s", name)
+		}
+		if name.array.Data().Buffers()[2] != names.array.Data().Buffers()[2] {
+			t.Errorf("expected the field to share the memory of the struct")
+		}
+
+		if _, err := structs.Field("missing"); err == nil {
+			t.Errorf("expected missing field error, got nil")
+		}
+	})
+
+	t.Run("null structs make null fields", func(t *testing.T) {
+		ids := FromSlice("id", []int64{1, 2, 3})
+		defer ids.Release()
+
+		// The second struct is null while its id is not
+		nullBitmap := memory.NewBufferBytes([]byte{0b101})
+		structArr, err := array.NewStructArrayWithNulls([]arrow.Array{ids.array}, []string{"id"}, nullBitmap, 1, 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer structArr.Release()
+
+		s := NewSeries("test_event", structArr)
+		defer s.Release()
+
+		structs, err := s.Struct()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		id, err := structs.Field("id")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer id.Release()
+
+		idArr := id.array.(*array.Int64)
+		if idArr.NullN() != 1 || !idArr.IsNull(1) || idArr.Value(2) != 3 {
+			t.Errorf("expected [1 null 3], got %s", idArr)
+		}
+	})
+}
@@ -0,0 +1,214 @@
+package series
+
+import (
+	"github.com/SHIMA0111/gleam/gleam/utils"
+	"github.com/apache/arrow-go/v18/arrow"
+)
+
+// AggregateOption configures an aggregation such as Sum, Mean, Min or Max.
+type AggregateOption func(*aggregateOptions)
+
+type aggregateOptions struct {
+	overflow utils.OverflowPolicy
+	sumMode  utils.SumMode
+	nan      utils.NaNPolicy
+}
+
+func newAggregateOptions(opts []AggregateOption) aggregateOptions {
+	options := aggregateOptions{
+		overflow: utils.OverflowPromoteToDecimal,
+		sumMode:  utils.SumFast,
+		nan:      utils.NaNPropagate,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return options
+}
+
+// WithOverflowPolicy sets what an integer aggregation does when its result does not fit in 64 bits.
+// The default is utils.OverflowPromoteToDecimal.
+func WithOverflowPolicy(policy utils.OverflowPolicy) AggregateOption {
+	return func(o *aggregateOptions) {
+		o.overflow = policy
+	}
+}
+
+// WithSumMode sets how Sum and Mean accumulate float values. The default is utils.SumFast;
+// utils.SumPrecise gives compensated sums that are bit-identical across runs and machines.
+func WithSumMode(mode utils.SumMode) AggregateOption {
+	return func(o *aggregateOptions) {
+		o.sumMode = mode
+	}
+}
+
+// WithNaNPolicy sets how Sum, Mean, Min and Max treat NaN values of a float Series.
+// The default is utils.NaNPropagate. Null values are skipped regardless of the policy.
+func WithNaNPolicy(policy utils.NaNPolicy) AggregateOption {
+	return func(o *aggregateOptions) {
+		o.nan = policy
+	}
+}
+
+// CastOption configures a Cast.
+type CastOption func(*castOptions)
+
+type castOptions struct {
+	unit      arrow.TimeUnit
+	timeZone  string
+	layout    string
+	precision int32
+	scale     int32
+	rounding  utils.RoundingMode
+	element   DataType
+	byteWidth int
+}
+
+func newCastOptions(opts []CastOption) castOptions {
+	options := castOptions{
+		unit:     arrow.Microsecond,
+		rounding: utils.RoundHalfEven,
+		element:  Unsupported,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return options
+}
+
+// WithTimeUnit sets the unit of a Timestamp, Duration or Time64 cast. The default is arrow.Microsecond.
+func WithTimeUnit(unit arrow.TimeUnit) CastOption {
+	return func(o *castOptions) {
+		o.unit = unit
+	}
+}
+
+// WithTimeZone sets the IANA time zone of a Timestamp cast, e.g. "Asia/Tokyo".
+// The default is no time zone, which reads and formats timestamps in UTC.
+func WithTimeZone(zone string) CastOption {
+	return func(o *castOptions) {
+		o.timeZone = zone
+	}
+}
+
+// WithLayout sets the Go time layout (see time.Layout) that parses strings cast to Date32, Timestamp or Time64,
+// and formats those types cast to String. The default is time.DateOnly for dates, time.RFC3339Nano for timestamps
+// and "15:04:05.999999999" for times of day. Durations always use the time.Duration format, e.g. "1h30m".
+func WithLayout(layout string) CastOption {
+	return func(o *castOptions) {
+		o.layout = layout
+	}
+}
+
+// WithDecimal sets the precision (the total number of digits) and the scale (the number of fractional digits)
+// of a Decimal128 or Decimal256 cast. The default is the maximum precision of the type and scale 0.
+func WithDecimal(precision, scale int32) CastOption {
+	return func(o *castOptions) {
+		o.precision = precision
+		o.scale = scale
+	}
+}
+
+// WithRoundingMode sets how a cast to a decimal type rounds values with more fractional digits than its scale.
+// The default is utils.RoundHalfEven.
+func WithRoundingMode(mode utils.RoundingMode) CastOption {
+	return func(o *castOptions) {
+		o.rounding = mode
+	}
+}
+
+// WithElementType sets the element type of a List cast. The other options apply to the element type,
+// e.g. WithTimeUnit to a List of Timestamp. A List cast needs an element type.
+func WithElementType(dtype DataType) CastOption {
+	return func(o *castOptions) {
+		o.element = dtype
+	}
+}
+
+// WithByteWidth sets the number of bytes of every value of a FixedSizeBinary cast. A FixedSizeBinary cast needs it.
+func WithByteWidth(width int) CastOption {
+	return func(o *castOptions) {
+		o.byteWidth = width
+	}
+}
+
+// SortOption configures a Sort or ArgSort.
+type SortOption func(*sortOptions)
+
+type sortOptions struct {
+	descending bool
+	nulls      utils.NullPlacement
+	nan        utils.NaNPlacement
+	stable     bool
+}
+
+func newSortOptions(opts []SortOption) sortOptions {
+	options := sortOptions{
+		nulls:  utils.NullsLast,
+		nan:    utils.NaNLast,
+		stable: true,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return options
+}
+
+// WithDescending sorts from the largest to the smallest value if descending is true. The default is ascending.
+func WithDescending(descending bool) SortOption {
+	return func(o *sortOptions) {
+		o.descending = descending
+	}
+}
+
+// WithNullPlacement sets where a sort puts the null values. The default is utils.NullsLast.
+func WithNullPlacement(placement utils.NullPlacement) SortOption {
+	return func(o *sortOptions) {
+		o.nulls = placement
+	}
+}
+
+// WithNaNPlacement sets where a sort puts the NaN values of a float Series. The default is utils.NaNLast.
+func WithNaNPlacement(placement utils.NaNPlacement) SortOption {
+	return func(o *sortOptions) {
+		o.nan = placement
+	}
+}
+
+// WithStable sets whether a sort keeps equal values in their order in the Series. The default is true;
+// an unstable sort can be faster but the order of equal values may then differ between runs.
+func WithStable(stable bool) SortOption {
+	return func(o *sortOptions) {
+		o.stable = stable
+	}
+}
+
+// ArithmeticOption configures an arithmetic operation such as Add or Mul.
+type ArithmeticOption func(*arithmeticOptions)
+
+type arithmeticOptions struct {
+	overflow utils.OverflowPolicy
+}
+
+func newArithmeticOptions(opts []ArithmeticOption) arithmeticOptions {
+	options := arithmeticOptions{
+		overflow: utils.OverflowError,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return options
+}
+
+// WithIntegerOverflow sets what an integer arithmetic operation does when a result does not fit in the result type.
+// The default is utils.OverflowError. utils.OverflowPromoteToDecimal makes the whole result a Decimal128 of scale 0,
+// or a Decimal256 if a result has more than 38 digits, once any result overflows.
+func WithIntegerOverflow(policy utils.OverflowPolicy) ArithmeticOption {
+	return func(o *arithmeticOptions) {
+		o.overflow = policy
+	}
+}
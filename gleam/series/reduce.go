@@ -0,0 +1,78 @@
+package series
+
+import (
+	"context"
+
+	"github.com/SHIMA0111/gleam/gleam/utils"
+	"github.com/SHIMA0111/gleam/internal/compute/array"
+	"github.com/SHIMA0111/gleam/internal/compute/parallel"
+	"github.com/apache/arrow-go/v18/arrow"
+)
+
+// ConcurrentReduceThreshold is the Series length from which Min, Max, Mean and Count, and the hashing of
+// Unique, NUnique and ValueCounts, reduce one chunk per CPU concurrently and merge the partial results.
+const ConcurrentReduceThreshold = 100_000
+
+// PreciseChunkSize is the chunk length of a Sum or Mean in utils.SumPrecise mode.
+// Unlike the other reductions, it does not follow the CPU count, so the partial sums and
+// their merge order, and therefore the result, are the same on every machine.
+const PreciseChunkSize = 1 << 16
+
+// reduceChunkSize returns the parallel chunk size of a Min, Max, Mean, Count or hashing over the Series:
+// 0, which reduces the Series in one piece, below ConcurrentReduceThreshold and one chunk per CPU otherwise.
+func (s *Series) reduceChunkSize() int {
+	if s.Len() < ConcurrentReduceThreshold {
+		return 0
+	}
+
+	return parallel.ChunkSize(s.Len())
+}
+
+// withNaNPolicy returns the partial state function that honors the NaN policy,
+// given the NaN-propagating function and the NaN-skipping one.
+func withNaNPolicy[P any](
+	policy utils.NaNPolicy,
+	stateOf func(context.Context, arrow.Array) (P, error),
+	skipNaNStateOf func(context.Context, arrow.Array) (P, error),
+) func(context.Context, arrow.Array) (P, error) {
+	switch policy {
+	case utils.NaNSkip:
+		return skipNaNStateOf
+	case utils.NaNError:
+		return array.RejectNaN(stateOf)
+	default:
+		return stateOf
+	}
+}
+
+// reduceSeries reduces every chunk of the Series with parallel.Reduce and merges the partial results in chunk order.
+// Empty chunks are skipped unless every chunk is empty, so that there is always a partial result.
+func reduceSeries[P any](
+	ctx context.Context,
+	s *Series,
+	chunkSize int,
+	stateOf func(context.Context, arrow.Array) (P, error),
+	merge func(P, P) P,
+) (P, error) {
+	var result P
+	reduced := false
+	for _, chunk := range s.chunks {
+		if chunk.Len() == 0 && (reduced || s.Len() > 0) {
+			continue
+		}
+
+		partial, err := parallel.Reduce(ctx, chunk, chunkSize, stateOf, merge)
+		if err != nil {
+			var zero P
+			return zero, err
+		}
+
+		if reduced {
+			result = merge(result, partial)
+		} else {
+			result, reduced = partial, true
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,228 @@
+package series
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/scalar"
+
+	"github.com/SHIMA0111/gleam/internal/compute/array"
+	internalUtils "github.com/SHIMA0111/gleam/internal/utils"
+)
+
+// Scalar is a single value of a Series data type, such as the result of an aggregation.
+// A Scalar can be null, e.g. the Max of a Series whose elements are all null.
+// The zero Scalar, which the *Value methods return with an error, is a null of the Null type.
+type Scalar struct {
+	value scalar.Scalar
+}
+
+// DType returns the data type of the Scalar.
+func (s Scalar) DType() arrow.DataType {
+	if s.value == nil {
+		return arrow.Null
+	}
+
+	return s.value.DataType()
+}
+
+// IsNull reports whether the Scalar is null.
+func (s Scalar) IsNull() bool {
+	return s.value == nil || !s.value.IsValid()
+}
+
+// Int64 returns the value of an integer Scalar as int64.
+// Returns an error if the Scalar is null, is not an integer or does not fit in int64.
+func (s Scalar) Int64() (int64, error) {
+	if s.IsNull() {
+		return 0, fmt.Errorf("cannot convert null %s scalar to int64", s.DType())
+	}
+
+	switch v := s.value.(type) {
+	case *scalar.Int8:
+		return int64(v.Value), nil
+	case *scalar.Int16:
+		return int64(v.Value), nil
+	case *scalar.Int32:
+		return int64(v.Value), nil
+	case *scalar.Int64:
+		return v.Value, nil
+	case *scalar.Uint8:
+		return int64(v.Value), nil
+	case *scalar.Uint16:
+		return int64(v.Value), nil
+	case *scalar.Uint32:
+		return int64(v.Value), nil
+	case *scalar.Uint64:
+		if v.Value > math.MaxInt64 {
+			return 0, fmt.Errorf("%d overflows int64", v.Value)
+		}
+		return int64(v.Value), nil
+	case *scalar.Decimal128:
+		scale := v.Type.(*arrow.Decimal128Type).Scale
+		if scale != 0 || v.Value.HighBits() != int64(v.Value.LowBits())>>63 {
+			return 0, fmt.Errorf("%s overflows int64", v.Value.ToString(scale))
+		}
+		return int64(v.Value.LowBits()), nil
+	default:
+		return 0, fmt.Errorf("cannot convert %s scalar to int64", s.DType())
+	}
+}
+
+// Uint64 returns the value of an integer Scalar as uint64.
+// Returns an error if the Scalar is null, is not an integer or does not fit in uint64.
+func (s Scalar) Uint64() (uint64, error) {
+	if s.IsNull() {
+		return 0, fmt.Errorf("cannot convert null %s scalar to uint64", s.DType())
+	}
+
+	switch v := s.value.(type) {
+	case *scalar.Uint8:
+		return uint64(v.Value), nil
+	case *scalar.Uint16:
+		return uint64(v.Value), nil
+	case *scalar.Uint32:
+		return uint64(v.Value), nil
+	case *scalar.Uint64:
+		return v.Value, nil
+	case *scalar.Decimal128:
+		scale := v.Type.(*arrow.Decimal128Type).Scale
+		if scale != 0 || v.Value.HighBits() != 0 {
+			return 0, fmt.Errorf("%s overflows uint64", v.Value.ToString(scale))
+		}
+		return v.Value.LowBits(), nil
+	}
+
+	i64, err := s.Int64()
+	if err != nil {
+		return 0, fmt.Errorf("cannot convert %s scalar to uint64: %w", s.DType(), err)
+	}
+	if i64 < 0 {
+		return 0, fmt.Errorf("%d overflows uint64", i64)
+	}
+
+	return uint64(i64), nil
+}
+
+// Float64 returns the value of a numeric Scalar as float64. Integers beyond 2^53 are rounded to the nearest float64.
+// Returns an error if the Scalar is null or not numeric.
+func (s Scalar) Float64() (float64, error) {
+	if s.IsNull() {
+		return 0, fmt.Errorf("cannot convert null %s scalar to float64", s.DType())
+	}
+
+	switch v := s.value.(type) {
+	case *scalar.Float32:
+		return float64(v.Value), nil
+	case *scalar.Float64:
+		return v.Value, nil
+	case *scalar.Uint64:
+		return float64(v.Value), nil
+	case *scalar.Decimal128:
+		return v.Value.ToFloat64(v.Type.(*arrow.Decimal128Type).Scale), nil
+	case *scalar.Decimal256:
+		return v.Value.ToFloat64(v.Type.(*arrow.Decimal256Type).Scale), nil
+	}
+
+	i64, err := s.Int64()
+	if err != nil {
+		return 0, fmt.Errorf("cannot convert %s scalar to float64", s.DType())
+	}
+
+	return float64(i64), nil
+}
+
+// Bool returns the value of a Boolean Scalar. Returns an error if the Scalar is null or not Boolean.
+func (s Scalar) Bool() (bool, error) {
+	if s.IsNull() {
+		return false, fmt.Errorf("cannot convert null %s scalar to bool", s.DType())
+	}
+
+	v, ok := s.value.(*scalar.Boolean)
+	if !ok {
+		return false, fmt.Errorf("cannot convert %s scalar to bool", s.DType())
+	}
+
+	return v.Value, nil
+}
+
+// Time returns the value of a Date32, Date64 or Timestamp Scalar as a time.Time.
+// Dates are midnight UTC and timestamps are in the time zone of their type (UTC if it has none).
+// Returns an error if the Scalar is null or of another type.
+func (s Scalar) Time() (time.Time, error) {
+	if s.IsNull() {
+		return time.Time{}, fmt.Errorf("cannot convert null %s scalar to time.Time", s.DType())
+	}
+
+	switch v := s.value.(type) {
+	case *scalar.Date32:
+		return v.Value.ToTime(), nil
+	case *scalar.Date64:
+		return v.Value.ToTime(), nil
+	case *scalar.Timestamp:
+		dt := v.Type.(*arrow.TimestampType)
+		loc, err := internalUtils.Location(dt.TimeZone)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return v.Value.ToTime(dt.Unit).In(loc), nil
+	default:
+		return time.Time{}, fmt.Errorf("cannot convert %s scalar to time.Time", s.DType())
+	}
+}
+
+// Duration returns the value of a Duration Scalar, or of a Time32 or Time64 Scalar as the time since midnight.
+// Returns an error if the Scalar is null or of another type.
+func (s Scalar) Duration() (time.Duration, error) {
+	if s.IsNull() {
+		return 0, fmt.Errorf("cannot convert null %s scalar to time.Duration", s.DType())
+	}
+
+	switch v := s.value.(type) {
+	case *scalar.Duration:
+		return time.Duration(v.Value) * v.Type.(*arrow.DurationType).Unit.Multiplier(), nil
+	case *scalar.Time32:
+		return time.Duration(v.Value) * v.Type.(*arrow.Time32Type).Unit.Multiplier(), nil
+	case *scalar.Time64:
+		return time.Duration(v.Value) * v.Type.(*arrow.Time64Type).Unit.Multiplier(), nil
+	default:
+		return 0, fmt.Errorf("cannot convert %s scalar to time.Duration", s.DType())
+	}
+}
+
+// String returns the value of the Scalar formatted as text, or "null" if it is null.
+// A Categorical Scalar is formatted as its category.
+func (s Scalar) String() string {
+	if dictScalar, ok := s.value.(*scalar.Dictionary); ok && dictScalar.IsValid() {
+		if value, err := dictScalar.GetEncodedValue(); err == nil {
+			return value.String()
+		}
+	}
+	if s.value == nil {
+		return "null"
+	}
+
+	return s.value.String()
+}
+
+// ArrowScalar returns the underlying arrow scalar, or a null scalar for the zero Scalar.
+func (s Scalar) ArrowScalar() scalar.Scalar {
+	if s.value == nil {
+		return scalar.ScalarNull
+	}
+
+	return s.value
+}
+
+// scalarSeries returns a one-row Series with the name and allocator of s holding the scalar.
+func (s *Series) scalarSeries(scl scalar.Scalar) (*Series, error) {
+	arr, err := array.MakeArrayFromScalar(scl, 1, s.mem)
+	if err != nil {
+		return nil, err
+	}
+	defer arr.Release()
+
+	return NewSeriesWithAllocator(s.name, arr, s.mem), nil
+}
@@ -0,0 +1,221 @@
+package series
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/SHIMA0111/gleam/gleam/utils"
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/arrow/scalar"
+)
+
+func TestSeries_AggregateValues(t *testing.T) {
+	// Setup memory allocator
+	mem := memory.NewGoAllocator()
+
+	builder := array.NewInt32Builder(mem)
+	defer builder.Release()
+
+	builder.AppendValues([]int32{4, -2, 0, 10}, []bool{true, true, false, true})
+	arr := builder.NewArray()
+	defer arr.Release()
+
+	s := NewSeries("test_values", arr)
+	defer s.Release()
+
+	t.Run("sum value", func(t *testing.T) {
+		sum, err := s.SumValue()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		v, err := sum.Int64()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if v != 12 {
+			t.Errorf("expected sum 12, got %d", v)
+		}
+		if !arrow.TypeEqual(sum.DType(), arrow.PrimitiveTypes.Int64) {
+			t.Errorf("expected Int64 sum, got %s", sum.DType())
+		}
+	})
+
+	t.Run("mean value", func(t *testing.T) {
+		mean, err := s.MeanValue()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		v, err := mean.Float64()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if v != 4 {
+			t.Errorf("expected mean 4, got %f", v)
+		}
+	})
+
+	t.Run("count value", func(t *testing.T) {
+		countValue, err := s.CountValue()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if count, err := countValue.Int64(); err != nil || count != 3 {
+			t.Errorf("expected count 3, got %s (%v)", countValue, err)
+		}
+	})
+
+	t.Run("min and max values", func(t *testing.T) {
+		minValue, err := s.MinValue()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		maxValue, err := s.MaxValue()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		minInt, _ := minValue.Int64()
+		maxInt, _ := maxValue.Int64()
+		if minInt != -2 || maxInt != 10 {
+			t.Errorf("expected min -2 and max 10, got %d and %d", minInt, maxInt)
+		}
+		if !arrow.TypeEqual(maxValue.DType(), arrow.PrimitiveTypes.Int32) {
+			t.Errorf("expected Int32 max, got %s", maxValue.DType())
+		}
+	})
+
+	t.Run("null max value", func(t *testing.T) {
+		nullBuilder := array.NewFloat64Builder(mem)
+		defer nullBuilder.Release()
+
+		nullBuilder.AppendNulls(3)
+		nullArr := nullBuilder.NewArray()
+		defer nullArr.Release()
+
+		nullSeries := NewSeries("test_null", nullArr)
+		defer nullSeries.Release()
+
+		maxValue, err := nullSeries.MaxValue()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !maxValue.IsNull() {
+			t.Errorf("expected null max, got %s", maxValue)
+		}
+		if _, err := maxValue.Float64(); err == nil {
+			t.Errorf("expected error converting a null scalar")
+		}
+	})
+}
+
+func TestScalar(t *testing.T) {
+	t.Run("integer conversions", func(t *testing.T) {
+		s := Scalar{value: scalar.NewUint64Scalar(math.MaxUint64)}
+
+		if _, err := s.Int64(); err == nil {
+			t.Errorf("expected overflow error converting MaxUint64 to int64")
+		}
+		if v, err := s.Uint64(); err != nil || v != math.MaxUint64 {
+			t.Errorf("expected %d, got %d (%v)", uint64(math.MaxUint64), v, err)
+		}
+
+		negative := Scalar{value: scalar.NewInt8Scalar(-3)}
+		if _, err := negative.Uint64(); err == nil {
+			t.Errorf("expected error converting a negative value to uint64")
+		}
+		if v, err := negative.Float64(); err != nil || v != -3 {
+			t.Errorf("expected -3, got %f (%v)", v, err)
+		}
+	})
+
+	t.Run("promoted decimal sum", func(t *testing.T) {
+		builder := array.NewInt64Builder(memory.NewGoAllocator())
+		defer builder.Release()
+
+		builder.AppendValues([]int64{math.MaxInt64, math.MaxInt64}, nil)
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		s := NewSeries("test_decimal", arr)
+		defer s.Release()
+
+		sum, err := s.SumValue(WithOverflowPolicy(utils.OverflowPromoteToDecimal))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if _, err := sum.Int64(); err == nil {
+			t.Errorf("expected overflow error converting the decimal sum to int64")
+		}
+		if v, err := sum.Uint64(); err != nil || v != math.MaxUint64-1 {
+			t.Errorf("expected %d, got %d (%v)", uint64(math.MaxUint64-1), v, err)
+		}
+		if sum.String() != "18446744073709551614" {
+			t.Errorf("expected 18446744073709551614, got %s", sum)
+		}
+	})
+
+	t.Run("bool", func(t *testing.T) {
+		s := Scalar{value: scalar.NewBooleanScalar(true)}
+		if v, err := s.Bool(); err != nil || !v {
+			t.Errorf("expected true, got %v (%v)", v, err)
+		}
+		if _, err := s.Int64(); err == nil {
+			t.Errorf("expected error converting a boolean to int64")
+		}
+	})
+
+	t.Run("zero value", func(t *testing.T) {
+		var s Scalar
+
+		if !s.IsNull() {
+			t.Errorf("expected the zero Scalar to be null")
+		}
+		if !arrow.TypeEqual(s.DType(), arrow.Null) {
+			t.Errorf("expected the null type, got %s", s.DType())
+		}
+		if s.String() != "null" {
+			t.Errorf("expected null, got %s", s)
+		}
+		if s.ArrowScalar().IsValid() {
+			t.Errorf("expected an invalid arrow scalar, got %s", s.ArrowScalar())
+		}
+		if _, err := s.Int64(); err == nil {
+			t.Errorf("expected error converting the zero Scalar")
+		}
+	})
+
+	t.Run("time and duration", func(t *testing.T) {
+		s := FromSlice("test_timestamp", []string{"2024-03-01T09:30:00+09:00", "2024-03-01T08:00:00+09:00"})
+		defer s.Release()
+
+		timestamps, err := s.Cast(Timestamp, WithTimeZone("Asia/Tokyo"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer timestamps.Release()
+
+		maxValue, err := timestamps.MaxValue()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		v, err := maxValue.Time()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if v.Hour() != 9 || v.Minute() != 30 || v.Location().String() != "Asia/Tokyo" {
+			t.Errorf("expected 09:30 in Asia/Tokyo, got %s", v)
+		}
+
+		d := Scalar{value: scalar.NewDurationScalar(90, &arrow.DurationType{Unit: arrow.Second})}
+		if v, err := d.Duration(); err != nil || v != 90*time.Second {
+			t.Errorf("expected 1m30s, got %s (%v)", v, err)
+		}
+	})
+}
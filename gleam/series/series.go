@@ -2,14 +2,22 @@ package series
 
 import (
 	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
 	"github.com/apache/arrow-go/v18/arrow/memory"
 )
 
-// Series represents a named collection of data stored as an Arrow array.
+// Series represents a named collection of data stored as Arrow arrays.
 // It supports various primitive data types through the array field.
+// The data may be split into several chunks, e.g. one per ingested batch; operations run chunk by chunk.
 type Series struct {
+	// array is the only chunk of a single-chunk Series, or nil if the Series has several chunks
 	array    arrow.Array
+	chunks   []arrow.Array
+	offsets  []int
 	name     string
 	datatype arrow.DataType
 	mem      memory.Allocator
@@ -23,46 +31,95 @@ func NewSeries(name string, array arrow.Array) *Series {
 func NewSeriesWithAllocator(name string, array arrow.Array, mem memory.Allocator) *Series {
 	array.Retain()
 
-	return &Series{
-		array:    array,
+	return newChunkedSeries(name, []arrow.Array{array}, array.DataType(), mem)
+}
+
+// NewChunkedSeries creates a new Series with the specified name from the chunks of the Chunked array,
+// without copying them. The reference counts of the chunks are retained.
+func NewChunkedSeries(name string, chunked *arrow.Chunked) *Series {
+	return NewChunkedSeriesWithAllocator(name, chunked, memory.DefaultAllocator)
+}
+
+// NewChunkedSeriesWithAllocator is NewChunkedSeries with the allocator of the operations on the Series.
+func NewChunkedSeriesWithAllocator(name string, chunked *arrow.Chunked, mem memory.Allocator) *Series {
+	chunks := chunked.Chunks()
+	if len(chunks) == 0 {
+		// A Series always has a chunk, so that its operations know the data type of their results
+		return newChunkedSeries(name, []arrow.Array{array.MakeArrayOfNull(mem, chunked.DataType(), 0)}, chunked.DataType(), mem)
+	}
+
+	retained := make([]arrow.Array, len(chunks))
+	for i, chunk := range chunks {
+		chunk.Retain()
+		retained[i] = chunk
+	}
+
+	return newChunkedSeries(name, retained, chunked.DataType(), mem)
+}
+
+// newChunkedSeries creates a Series that takes over the references of the chunks, of which there is at least one.
+func newChunkedSeries(name string, chunks []arrow.Array, dtype arrow.DataType, mem memory.Allocator) *Series {
+	offsets := make([]int, len(chunks)+1)
+	for i, chunk := range chunks {
+		offsets[i+1] = offsets[i] + chunk.Len()
+	}
+
+	s := &Series{
+		chunks:   chunks,
+		offsets:  offsets,
 		name:     name,
-		datatype: array.DataType(),
+		datatype: dtype,
 		mem:      mem,
 	}
+	if len(chunks) == 1 {
+		s.array = chunks[0]
+	}
+
+	return s
 }
 
 // Release releases the memory associated with the Series' underlying Arrow array, making it unavailable for further use.
 func (s *Series) Release() {
-	if s.array == nil {
+	if s.chunks == nil {
 		return
 	}
-	s.array.Release()
+	for _, chunk := range s.chunks {
+		chunk.Release()
+	}
 	s.array = nil
+	s.chunks = nil
 }
 
 // Len returns the number of elements in the Series.
 func (s *Series) Len() int {
-	return s.array.Len()
+	return s.offsets[len(s.offsets)-1]
 }
 
 // IsNull checks if the element at the given index i in the Series is null. Returns true if null, otherwise false.
 func (s *Series) IsNull(i int) bool {
-	return s.array.IsNull(i)
+	chunk, j := s.locate(i)
+	return chunk.IsNull(j)
 }
 
 // IsValid checks if the element at the given index i in the Series is valid (not null). Returns true if valid, false otherwise.
 func (s *Series) IsValid(i int) bool {
-	return s.array.IsValid(i)
+	chunk, j := s.locate(i)
+	return chunk.IsValid(j)
 }
 
 // NullCount returns the number of null (invalid) elements in the Series. It delegates to the underlying Arrow array NullN method.
 func (s *Series) NullCount() int {
-	return s.array.NullN()
+	nulls := 0
+	for _, chunk := range s.chunks {
+		nulls += chunk.NullN()
+	}
+
+	return nulls
 }
 
 // DType returns the data type of the Series.
 func (s *Series) DType() arrow.DataType {
-	return s.array.DataType()
+	return s.datatype
 }
 
 // Name returns the name of the Series. It provides a way to identify the Series by a user-defined string.
@@ -70,17 +127,87 @@ func (s *Series) Name() string {
 	return s.name
 }
 
+// NumChunks returns the number of chunks the data of the Series is split into.
+func (s *Series) NumChunks() int {
+	return len(s.chunks)
+}
+
+// Chunked returns the chunks of the Series as an arrow Chunked array sharing their memory.
+// The caller must release it.
+func (s *Series) Chunked() *arrow.Chunked {
+	return arrow.NewChunked(s.datatype, s.chunks)
+}
+
 // String returns a string representation of the Series, including its name and data content.
 func (s *Series) String() string {
-	if s.array == nil {
+	if s.chunks == nil {
 		return ""
 	}
 
+	if s.array != nil {
+		return fmt.Sprintf(
+			"Series: %s Type: %s\n%s", s.Name(), s.datatype, s.array,
+		)
+	}
+
+	chunks := make([]string, len(s.chunks))
+	for i, chunk := range s.chunks {
+		chunks[i] = fmt.Sprint(chunk)
+	}
+
 	return fmt.Sprintf(
-		"Series: %s Type: %s\n%s", s.Name(), s.datatype, s.array,
+		"Series: %s Type: %s Chunks: %d\n%s", s.Name(), s.datatype, len(s.chunks), strings.Join(chunks, "\n"),
 	)
 }
 
 func (s *Series) underlyingArray() arrow.Array {
 	return s.array
 }
+
+// locate returns the chunk holding the element i of the Series and the index of the element in the chunk.
+func (s *Series) locate(i int) (arrow.Array, int) {
+	if s.array != nil {
+		return s.array, i
+	}
+
+	// offsets[c] is the index of the first element of chunk c, so the chunk is the last one starting at or before i
+	c := sort.SearchInts(s.offsets[1:], i+1)
+	if c == len(s.chunks) {
+		panic(fmt.Sprintf("index %d out of range [0:%d]", i, s.Len()))
+	}
+
+	return s.chunks[c], i - s.offsets[c]
+}
+
+// singleChunk returns the only chunk of the Series, for the operations that need contiguous data.
+// Returns an error naming the operation if the Series has several chunks.
+func (s *Series) singleChunk(operation string) (arrow.Array, error) {
+	if s.array == nil {
+		return nil, fmt.Errorf("cannot %s Series %q with %d chunks, call Rechunk first", operation, s.name, len(s.chunks))
+	}
+
+	return s.array, nil
+}
+
+// withChunks returns a Series with the name and allocator of s that takes over the references of the chunks.
+func (s *Series) withChunks(chunks []arrow.Array) *Series {
+	return newChunkedSeries(s.name, chunks, chunks[0].DataType(), s.mem)
+}
+
+// mapChunks applies fn to every chunk of the Series and returns the results as a Series with the same chunks.
+// fn returns a new reference; if it fails, the results so far are released and the error is returned.
+func (s *Series) mapChunks(fn func(chunk arrow.Array) (arrow.Array, error)) (*Series, error) {
+	results := make([]arrow.Array, 0, len(s.chunks))
+	for _, chunk := range s.chunks {
+		result, err := fn(chunk)
+		if err != nil {
+			for _, done := range results {
+				done.Release()
+			}
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	return s.withChunks(results), nil
+}
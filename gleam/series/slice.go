@@ -0,0 +1,88 @@
+package series
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	arrowArray "github.com/apache/arrow-go/v18/arrow/array"
+
+	"github.com/SHIMA0111/gleam/internal/compute/array"
+)
+
+// Slice returns the length elements of the Series starting at offset, as a view sharing the memory of the Series.
+// The view keeps the memory alive until it is released, even if the Series is released first.
+// Returns an error if the range is not within the Series.
+func (s *Series) Slice(offset, length int) (*Series, error) {
+	if offset < 0 || length < 0 || offset+length > s.Len() {
+		return nil, fmt.Errorf("slice [%d:%d] out of range for Series %q of length %d", offset, offset+length, s.name, s.Len())
+	}
+
+	return s.slice(offset, offset+length), nil
+}
+
+// Head returns the first n elements of the Series as a view sharing its memory, or the whole Series if it is shorter.
+func (s *Series) Head(n int) *Series {
+	return s.slice(0, min(max(n, 0), s.Len()))
+}
+
+// Tail returns the last n elements of the Series as a view sharing its memory, or the whole Series if it is shorter.
+func (s *Series) Tail(n int) *Series {
+	return s.slice(s.Len()-min(max(n, 0), s.Len()), s.Len())
+}
+
+// slice returns a view of the elements [start, end) of the Series, with a chunk per chunk it overlaps.
+func (s *Series) slice(start, end int) *Series {
+	chunks := make([]arrow.Array, 0, 1)
+	for c, chunk := range s.chunks {
+		chunkStart, chunkEnd := s.offsets[c], s.offsets[c+1]
+		if chunkEnd <= start || chunkStart >= end {
+			continue
+		}
+		from := max(start, chunkStart) - chunkStart
+		to := min(end, chunkEnd) - chunkStart
+		chunks = append(chunks, arrowArray.NewSlice(chunk, int64(from), int64(to)))
+	}
+
+	if len(chunks) == 0 {
+		// An empty slice keeps a chunk for the data type
+		chunks = append(chunks, arrowArray.NewSlice(s.chunks[0], 0, 0))
+	}
+
+	return s.withChunks(chunks)
+}
+
+// Take returns the elements of the Series at the positions held by the integer indices Series, in their order.
+// A null index gives a null element. The result has the name of the Series and the chunks of the indices.
+// Returns an error if the indices are not integers or an index is out of range.
+func (s *Series) Take(indices *Series) (*Series, error) {
+	return s.TakeCtx(context.Background(), indices)
+}
+
+// TakeCtx is Take with a caller-provided context.
+func (s *Series) TakeCtx(ctx context.Context, indices *Series) (*Series, error) {
+	values := s.array
+	if values == nil {
+		// Indices address the whole Series, so the chunks are gathered into one array to take from
+		concatenated, err := array.Concat(ctx, s.chunks, s.mem)
+		if err != nil {
+			return nil, err
+		}
+		defer concatenated.Release()
+		values = concatenated
+	}
+
+	chunks := make([]arrow.Array, 0, len(indices.chunks))
+	for _, indexChunk := range indices.chunks {
+		taken, err := array.Take(ctx, values, indexChunk)
+		if err != nil {
+			for _, done := range chunks {
+				done.Release()
+			}
+			return nil, err
+		}
+		chunks = append(chunks, taken)
+	}
+
+	return s.withChunks(chunks), nil
+}
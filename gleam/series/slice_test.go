@@ -0,0 +1,183 @@
+package series
+
+import (
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow/array"
+)
+
+func TestSeries_Slice(t *testing.T) {
+	t.Run("slice shares memory", func(t *testing.T) {
+		s := FromSlice("test_int64", []int64{1, 2, 3, 4, 5})
+
+		sliced, err := s.Slice(1, 3)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer sliced.Release()
+
+		if sliced.array.Data().Buffers()[1] != s.array.Data().Buffers()[1] {
+			t.Errorf("expected the slice to share the buffer of the Series")
+		}
+
+		// The slice keeps the data alive after the Series is released
+		s.Release()
+
+		values, _, err := Values[int64](sliced)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if sliced.Name() != "test_int64" || len(values) != 3 || values[0] != 2 || values[2] != 4 {
+			t.Errorf("expected [2 3 4], got %v", values)
+		}
+	})
+
+	t.Run("slice out of range", func(t *testing.T) {
+		s := FromSlice("test_int64", []int64{1, 2})
+		defer s.Release()
+
+		if _, err := s.Slice(1, 2); err == nil {
+			t.Errorf("expected out of range error, got nil")
+		}
+		if _, err := s.Slice(-1, 1); err == nil {
+			t.Errorf("expected out of range error, got nil")
+		}
+
+		empty, err := s.Slice(2, 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer empty.Release()
+		if empty.Len() != 0 {
+			t.Errorf("expected an empty Series, got %s", empty)
+		}
+	})
+
+	t.Run("slice across chunks", func(t *testing.T) {
+		s := newChunkedInt64(t, []int64{1, 2}, []int64{3, 0}, []int64{5})
+		defer s.Release()
+
+		sliced, err := s.Slice(1, 3)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer sliced.Release()
+
+		values, valid, err := Values[int64](sliced)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if sliced.NumChunks() != 2 || values[0] != 2 || values[1] != 3 || valid[2] {
+			t.Errorf("expected [2 3 null] in 2 chunks, got %s", sliced)
+		}
+	})
+
+	t.Run("head and tail", func(t *testing.T) {
+		s := FromSlice("test_string", []string{"a", "b", "c"})
+		defer s.Release()
+
+		head := s.Head(2)
+		defer head.Release()
+		if head.Len() != 2 || head.array.(*array.String).Value(1) != "b" {
+			t.Errorf("expected [a b], got %s", head)
+		}
+
+		tail := s.Tail(1)
+		defer tail.Release()
+		if tail.Len() != 1 || tail.array.(*array.String).Value(0) != "c" {
+			t.Errorf("expected [c], got %s", tail)
+		}
+
+		all := s.Tail(10)
+		defer all.Release()
+		if all.Len() != 3 {
+			t.Errorf("expected the whole Series, got %s", all)
+		}
+
+		none := s.Head(-1)
+		defer none.Release()
+		if none.Len() != 0 {
+			t.Errorf("expected an empty Series, got %s", none)
+		}
+	})
+}
+
+func TestSeries_Take(t *testing.T) {
+	t.Run("take with null indices", func(t *testing.T) {
+		s := FromSlice("test_string", []string{"a", "b", "c"})
+		defer s.Release()
+
+		last, first := int32(2), int32(0)
+		indices := FromPtrSlice("indices", []*int32{&last, nil, &first, &last})
+		defer indices.Release()
+
+		taken, err := s.Take(indices)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer taken.Release()
+
+		takenArr := taken.array.(*array.String)
+		if taken.Name() != "test_string" || takenArr.Value(0) != "c" || !takenArr.IsNull(1) ||
+			takenArr.Value(2) != "a" || takenArr.Value(3) != "c" {
+			t.Errorf("expected [c null a c], got %s", taken)
+		}
+	})
+
+	t.Run("take from chunks and categoricals", func(t *testing.T) {
+		s := newChunkedInt64(t, []int64{1, 2}, []int64{3})
+		defer s.Release()
+
+		indices := FromSlice("indices", []uint8{2, 0})
+		defer indices.Release()
+
+		taken, err := s.Take(indices)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer taken.Release()
+
+		values, _, err := Values[int64](taken)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(values) != 2 || values[0] != 3 || values[1] != 1 {
+			t.Errorf("expected [3 1], got %v", values)
+		}
+
+		strs := FromSlice("test_category", []string{"x", "y", "x"})
+		defer strs.Release()
+		categories, err := strs.Cast(Categorical)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer categories.Release()
+
+		takenCategories, err := categories.Take(indices)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer takenCategories.Release()
+
+		if takenCategories.Len() != 2 || takenCategories.DType().String() != categories.DType().String() {
+			t.Errorf("expected 2 categories, got %s", takenCategories)
+		}
+	})
+
+	t.Run("invalid indices", func(t *testing.T) {
+		s := FromSlice("test_int64", []int64{1, 2})
+		defer s.Release()
+
+		outOfRange := FromSlice("indices", []int64{2})
+		defer outOfRange.Release()
+		if _, err := s.Take(outOfRange); err == nil {
+			t.Errorf("expected out of range error, got nil")
+		}
+
+		floats := FromSlice("indices", []float64{0})
+		defer floats.Release()
+		if _, err := s.Take(floats); err == nil {
+			t.Errorf("expected non-integer indices error, got nil")
+		}
+	})
+}
@@ -0,0 +1,70 @@
+package series
+
+import (
+	"context"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+
+	"github.com/SHIMA0111/gleam/internal/compute/array"
+	"github.com/SHIMA0111/gleam/internal/compute/parallel"
+)
+
+// ConcurrentSortThreshold is the Series length from which Sort and ArgSort sort one run per CPU concurrently
+// and merge the sorted runs.
+const ConcurrentSortThreshold = 100_000
+
+// Sort returns the elements of the Series in ascending order, with the nulls last, as a single-chunk Series.
+// The order and the placement of nulls and NaN values are set by the options, and the sort is stable by default.
+// Strings and binary values sort by their bytes and a Categorical Series sorts by its categories.
+// Returns an error if the data type has no order, e.g. List or Struct.
+func (s *Series) Sort(opts ...SortOption) (*Series, error) {
+	return s.SortCtx(context.Background(), opts...)
+}
+
+// SortCtx is Sort with a caller-provided context.
+func (s *Series) SortCtx(ctx context.Context, opts ...SortOption) (*Series, error) {
+	return s.sorted(ctx, opts, array.Sort)
+}
+
+// ArgSort returns the positions of the elements of the Series in the order Sort puts them, as an Int64 Series
+// with the name of the Series. s.Take(indices) with the result gives the sorted Series.
+func (s *Series) ArgSort(opts ...SortOption) (*Series, error) {
+	return s.ArgSortCtx(context.Background(), opts...)
+}
+
+// ArgSortCtx is ArgSort with a caller-provided context.
+func (s *Series) ArgSortCtx(ctx context.Context, opts ...SortOption) (*Series, error) {
+	return s.sorted(ctx, opts, array.ArgSort)
+}
+
+func (s *Series) sorted(
+	ctx context.Context,
+	opts []SortOption,
+	sortFn func(context.Context, arrow.Array, array.SortOptions, memory.Allocator) (arrow.Array, error),
+) (*Series, error) {
+	options := newSortOptions(opts)
+	sortOptions := array.SortOptions{
+		Descending:    options.descending,
+		NullPlacement: options.nulls,
+		NaNPlacement:  options.nan,
+		Stable:        options.stable,
+	}
+	if s.Len() >= ConcurrentSortThreshold {
+		sortOptions.ChunkSize = parallel.ChunkSize(s.Len())
+	}
+
+	// The order spans the chunks, so they are sorted as one array
+	values, err := s.RechunkCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer values.Release()
+
+	result, err := sortFn(ctx, values.array, sortOptions, s.mem)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.withChunks([]arrow.Array{result}), nil
+}
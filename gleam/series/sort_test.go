@@ -0,0 +1,181 @@
+package series
+
+import (
+	"math"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow/array"
+
+	"github.com/SHIMA0111/gleam/gleam/utils"
+)
+
+func TestSeries_Sort(t *testing.T) {
+	t.Run("ascending with nulls last", func(t *testing.T) {
+		s := newChunkedInt64(t, []int64{3, 0, 1}, []int64{2, 0})
+		defer s.Release()
+
+		sorted, err := s.Sort()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer sorted.Release()
+
+		values, valid, err := Values[int64](sorted)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if sorted.NumChunks() != 1 || values[0] != 1 || values[1] != 2 || values[2] != 3 || valid[3] || valid[4] {
+			t.Errorf("expected [1 2 3 null null], got %s", sorted)
+		}
+	})
+
+	t.Run("descending with nulls first", func(t *testing.T) {
+		s := newChunkedInt64(t, []int64{3, 0, 1, 2})
+		defer s.Release()
+
+		sorted, err := s.Sort(WithDescending(true), WithNullPlacement(utils.NullsFirst))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer sorted.Release()
+
+		values, valid, err := Values[int64](sorted)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if valid[0] || values[1] != 3 || values[2] != 2 || values[3] != 1 {
+			t.Errorf("expected [null 3 2 1], got %s", sorted)
+		}
+	})
+
+	t.Run("NaN placement", func(t *testing.T) {
+		s, err := FromSliceWithValidity("test_float64", []float64{2, math.NaN(), 0, -1}, []bool{true, true, false, true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer s.Release()
+
+		sorted, err := s.Sort()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer sorted.Release()
+
+		sortedArr := sorted.array.(*array.Float64)
+		if sortedArr.Value(0) != -1 || sortedArr.Value(1) != 2 || !math.IsNaN(sortedArr.Value(2)) || !sortedArr.IsNull(3) {
+			t.Errorf("expected [-1 2 NaN null], got %s", sortedArr)
+		}
+
+		nanFirst, err := s.Sort(WithNaNPlacement(utils.NaNFirst), WithDescending(true))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer nanFirst.Release()
+
+		nanFirstArr := nanFirst.array.(*array.Float64)
+		if !math.IsNaN(nanFirstArr.Value(0)) || nanFirstArr.Value(1) != 2 || nanFirstArr.Value(2) != -1 || !nanFirstArr.IsNull(3) {
+			t.Errorf("expected [NaN 2 -1 null], got %s", nanFirstArr)
+		}
+	})
+
+	t.Run("strings and categoricals", func(t *testing.T) {
+		s := FromSlice("test_string", []string{"pear", "apple", "fig", "apple"})
+		defer s.Release()
+
+		sorted, err := s.Sort()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer sorted.Release()
+
+		values, _, err := Values[string](sorted)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if values[0] != "apple" || values[1] != "apple" || values[2] != "fig" || values[3] != "pear" {
+			t.Errorf("expected [apple apple fig pear], got %v", values)
+		}
+
+		// The categories are coded in order of appearance, so sorting by codes would put pear first
+		categories, err := s.Cast(Categorical)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer categories.Release()
+
+		indices, err := categories.ArgSort()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer indices.Release()
+
+		positions, _, err := Values[int64](indices)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if positions[0] != 1 || positions[1] != 3 || positions[2] != 2 || positions[3] != 0 {
+			t.Errorf("expected [1 3 2 0], got %v", positions)
+		}
+	})
+
+	t.Run("argsort feeds take", func(t *testing.T) {
+		s := FromSlice("test_int32", []int32{5, 1, 4})
+		defer s.Release()
+
+		indices, err := s.ArgSort(WithDescending(true))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer indices.Release()
+
+		taken, err := s.Take(indices)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer taken.Release()
+
+		values, _, err := Values[int32](taken)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if values[0] != 5 || values[1] != 4 || values[2] != 1 {
+			t.Errorf("expected [5 4 1], got %v", values)
+		}
+	})
+
+	t.Run("concurrent sort is stable", func(t *testing.T) {
+		n := ConcurrentSortThreshold + 1_000
+		keys := make([]int64, n)
+		for i := range keys {
+			keys[i] = int64((i * 7919) % 10)
+		}
+		s := FromSlice("test_int64", keys)
+		defer s.Release()
+
+		indices, err := s.ArgSort()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer indices.Release()
+
+		positions, _, err := Values[int64](indices)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for k := 1; k < n; k++ {
+			prev, cur := positions[k-1], positions[k]
+			if keys[prev] > keys[cur] || (keys[prev] == keys[cur] && prev > cur) {
+				t.Fatalf("expected a stable ascending order, got position %d before %d", prev, cur)
+			}
+		}
+	})
+
+	t.Run("unordered type", func(t *testing.T) {
+		s := FromListSlice("test_tags", [][]string{{"a"}})
+		defer s.Release()
+
+		if _, err := s.Sort(); err == nil {
+			t.Errorf("expected unsupported type error, got nil")
+		}
+	})
+}
@@ -2,95 +2,85 @@ package series
 
 import (
 	"context"
+	"github.com/SHIMA0111/gleam/gleam/utils"
 	internalCompute "github.com/SHIMA0111/gleam/internal/compute/array"
-	"github.com/apache/arrow-go/v18/arrow"
-	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/SHIMA0111/gleam/internal/compute/parallel"
 	"github.com/apache/arrow-go/v18/arrow/scalar"
-	"runtime"
-	"sync"
 )
 
 const ConcurrentSumThreshold = 100_000
 
-// Sum calculates the sum of all elements in the Series,
-// returning the result as a new Series with 64-bit float Series. Or, returns an error if unsupported.
+// Sum calculates the sum of all elements in the Series, returning the result as a new one-row Series.
+// Integer Series are summed exactly: signed integers produce an Int64 Series and unsigned integers a Uint64 Series,
+// and a total that does not fit in 64 bits is handled by the overflow policy (see WithOverflowPolicy).
+// Float Series produce a Float64 Series, accumulated as selected by WithSumMode,
+// with NaN values handled by the NaN policy (see WithNaNPolicy).
+// Decimal Series are summed exactly into a decimal of precision 38 (Decimal128) or 76 (Decimal256) with the same scale;
+// a Decimal128 total beyond 38 digits is promoted to Decimal256 or handled by the overflow policy.
+// Returns an error if the data type is unsupported.
 // In arrow-go, there is a math.(Int64, UInt64, Float64).Sum, which is the optimized function with assembly.
 // We use this method with cast the array data type.
 // However, in a small sum execution, the Go loop is faster than the arrow sum function
 // what from the overhead cast and so. (In small, the 64-bit numeric is still fastest)
 // Sum uses a threshold to judge the sum operation method, go loop and cast and arrow sum.
-func (s *Series) Sum() (*Series, error) {
-	ctx := context.Background()
-
-	var sumArr arrow.Array
-	var err error
-
-	if s.Len() < ConcurrentSumThreshold {
-		sumArr, err = s.sum(ctx)
-	} else {
-		sumArr, err = s.concurrentSum(ctx)
-	}
+func (s *Series) Sum(opts ...AggregateOption) (*Series, error) {
+	return s.SumCtx(context.Background(), opts...)
+}
 
+// SumCtx is Sum with a caller-provided context. The sum stops with the context error once ctx is done.
+func (s *Series) SumCtx(ctx context.Context, opts ...AggregateOption) (*Series, error) {
+	scl, err := s.sum(ctx, newAggregateOptions(opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return NewSeries(s.name, sumArr), nil
+	return s.scalarSeries(scl)
 }
 
-func (s *Series) sum(ctx context.Context) (arrow.Array, error) {
-	droppedArray, err := internalCompute.DropNullArray(ctx, s.array)
-	if err != nil {
-		return nil, err
-	}
-	defer droppedArray.Release()
-
-	return internalCompute.SumArray(ctx, droppedArray, s.mem)
+// SumValue is Sum returning the sum as a Scalar instead of a one-row Series.
+func (s *Series) SumValue(opts ...AggregateOption) (Scalar, error) {
+	return s.SumValueCtx(context.Background(), opts...)
 }
 
-func (s *Series) concurrentSum(ctx context.Context) (arrow.Array, error) {
-	droppedArray, err := internalCompute.DropNullArray(ctx, s.array)
+// SumValueCtx is SumValue with a caller-provided context.
+func (s *Series) SumValueCtx(ctx context.Context, opts ...AggregateOption) (Scalar, error) {
+	scl, err := s.sum(ctx, newAggregateOptions(opts))
 	if err != nil {
-		return nil, err
+		return Scalar{}, err
 	}
-	defer droppedArray.Release()
 
-	// Go non-float number division works as a truncation float point so add 1
-	length := droppedArray.Len()
-	chunkSize := length/runtime.NumCPU() + 1
-
-	floatChan := make(chan float64, runtime.NumCPU())
-	var wg sync.WaitGroup
-
-	for i := 0; i < length; i += chunkSize {
-		wg.Add(1)
-		end := i + chunkSize
-		if end > length {
-			end = length
-		}
+	return Scalar{value: scl}, nil
+}
 
-		arrowView := array.NewSlice(droppedArray, int64(i), int64(end))
-		go func(av arrow.Array) {
-			sumVal, err := internalCompute.Sum(ctx, av)
-			if err != nil {
-				panic(err)
-			}
-			floatChan <- sumVal
-			av.Release()
-			wg.Done()
-		}(arrowView)
+func (s *Series) sum(ctx context.Context, options aggregateOptions) (scalar.Scalar, error) {
+	switch {
+	case options.sumMode == utils.SumPrecise:
+		return s.reduceSum(ctx, PreciseChunkSize, options)
+	case s.Len() < ConcurrentSumThreshold:
+		return s.reduceSum(ctx, 0, options)
+	default:
+		return s.reduceSum(ctx, parallel.ChunkSize(s.Len()), options)
 	}
+}
 
-	wg.Wait()
-	close(floatChan)
-
-	total := 0.
-	for res := range floatChan {
-		total += res
+// reduceSum sums the Series in chunks of chunkSize elements, or in one piece if chunkSize is 0.
+// The partial sums are merged in chunk order; the first chunk error is returned and stops the remaining chunks.
+func (s *Series) reduceSum(ctx context.Context, chunkSize int, options aggregateOptions) (scalar.Scalar, error) {
+	stateOf, skipNaNStateOf := internalCompute.SumStateOf, internalCompute.SkipNaNSumStateOf
+	if options.sumMode == utils.SumPrecise {
+		stateOf, skipNaNStateOf = internalCompute.PreciseSumStateOf, internalCompute.PreciseSkipNaNSumStateOf
 	}
 
-	scl := scalar.NewFloat64Scalar(total)
-	newArray, err := scalar.MakeArrayFromScalar(scl, 1, s.mem)
+	total, err := reduceSeries(
+		ctx,
+		s,
+		chunkSize,
+		withNaNPolicy(options.nan, stateOf, skipNaNStateOf),
+		internalCompute.SumState.Merge,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-	return newArray, nil
+	return total.Scalar(options.overflow)
 }
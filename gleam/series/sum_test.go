@@ -1,6 +1,9 @@
 package series
 
 import (
+	"context"
+	"errors"
+	"github.com/SHIMA0111/gleam/gleam/utils"
 	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
 	"github.com/apache/arrow-go/v18/arrow/memory"
@@ -40,9 +43,9 @@ func TestSeries_Sum(t *testing.T) {
 		}
 
 		// Access the underlying array to check value
-		resultArr := result.array.(*array.Float64)
-		if resultArr.Value(0) != 15. {
-			t.Errorf("expected sum 15, got %f", resultArr.Value(0))
+		resultArr := result.array.(*array.Int64)
+		if resultArr.Value(0) != 15 {
+			t.Errorf("expected sum 15, got %d", resultArr.Value(0))
 		}
 	})
 
@@ -72,9 +75,9 @@ func TestSeries_Sum(t *testing.T) {
 		}
 
 		// Access the underlying array to check value
-		resultArr := result.array.(*array.Float64)
-		if resultArr.Value(0) != 1500. {
-			t.Errorf("expected sum 1500, got %f", resultArr.Value(0))
+		resultArr := result.array.(*array.Int64)
+		if resultArr.Value(0) != 1500 {
+			t.Errorf("expected sum 1500, got %d", resultArr.Value(0))
 		}
 	})
 
@@ -104,9 +107,9 @@ func TestSeries_Sum(t *testing.T) {
 		}
 
 		// Access the underlying array to check value
-		resultArr := result.array.(*array.Float64)
-		if resultArr.Value(0) != 15000. {
-			t.Errorf("expected sum 15000, got %f", resultArr.Value(0))
+		resultArr := result.array.(*array.Int64)
+		if resultArr.Value(0) != 15000 {
+			t.Errorf("expected sum 15000, got %d", resultArr.Value(0))
 		}
 	})
 
@@ -136,9 +139,9 @@ func TestSeries_Sum(t *testing.T) {
 		}
 
 		// Access the underlying array to check value
-		resultArr := result.array.(*array.Float64)
-		if resultArr.Value(0) != 150000. {
-			t.Errorf("expected sum 150000, got %f", resultArr.Value(0))
+		resultArr := result.array.(*array.Int64)
+		if resultArr.Value(0) != 150000 {
+			t.Errorf("expected sum 150000, got %d", resultArr.Value(0))
 		}
 	})
 
@@ -169,9 +172,9 @@ func TestSeries_Sum(t *testing.T) {
 		}
 
 		// Access the underlying array to check value
-		resultArr := result.array.(*array.Float64)
-		if resultArr.Value(0) != 150. {
-			t.Errorf("expected sum 150, got %f", resultArr.Value(0))
+		resultArr := result.array.(*array.Uint64)
+		if resultArr.Value(0) != 150 {
+			t.Errorf("expected sum 150, got %d", resultArr.Value(0))
 		}
 	})
 
@@ -201,9 +204,9 @@ func TestSeries_Sum(t *testing.T) {
 		}
 
 		// Access the underlying array to check value
-		resultArr := result.array.(*array.Float64)
-		if resultArr.Value(0) != 15000. {
-			t.Errorf("expected sum 15000, got %f", resultArr.Value(0))
+		resultArr := result.array.(*array.Uint64)
+		if resultArr.Value(0) != 15000 {
+			t.Errorf("expected sum 15000, got %d", resultArr.Value(0))
 		}
 	})
 
@@ -233,9 +236,9 @@ func TestSeries_Sum(t *testing.T) {
 		}
 
 		// Access the underlying array to check value
-		resultArr := result.array.(*array.Float64)
-		if resultArr.Value(0) != 150000. {
-			t.Errorf("expected sum 150000, got %f", resultArr.Value(0))
+		resultArr := result.array.(*array.Uint64)
+		if resultArr.Value(0) != 150000 {
+			t.Errorf("expected sum 150000, got %d", resultArr.Value(0))
 		}
 	})
 
@@ -265,9 +268,9 @@ func TestSeries_Sum(t *testing.T) {
 		}
 
 		// Access the underlying array to check value
-		resultArr := result.array.(*array.Float64)
-		if resultArr.Value(0) != 1500000. {
-			t.Errorf("expected sum 1500000, got %f", resultArr.Value(0))
+		resultArr := result.array.(*array.Uint64)
+		if resultArr.Value(0) != 1500000 {
+			t.Errorf("expected sum 1500000, got %d", resultArr.Value(0))
 		}
 	})
 
@@ -364,9 +367,9 @@ func TestSeries_Sum(t *testing.T) {
 		}
 
 		// Access the underlying array to check value
-		resultArr := result.array.(*array.Float64)
-		if resultArr.Value(0) != 0. {
-			t.Errorf("expected sum 0 for empty array, got %f", resultArr.Value(0))
+		resultArr := result.array.(*array.Int64)
+		if resultArr.Value(0) != 0 {
+			t.Errorf("expected sum 0 for empty array, got %d", resultArr.Value(0))
 		}
 	})
 
@@ -400,7 +403,7 @@ func TestSeries_Sum(t *testing.T) {
 		}
 
 		// Access the underlying array to check value
-		resultArr := result.array.(*array.Float64)
+		resultArr := result.array.(*array.Int64)
 		// We don't check for a specific sum value here as the implementation
 		// might handle null values differently. We just verify that the operation
 		// completes successfully and returns a result of the correct type.
@@ -440,7 +443,7 @@ func TestSeries_Sum(t *testing.T) {
 		}
 		defer result.Release()
 
-		resultArr := result.array.(*array.Float64)
+		resultArr := result.array.(*array.Int64)
 
 		var expected int64
 		for i, v := range values {
@@ -449,13 +452,196 @@ func TestSeries_Sum(t *testing.T) {
 			}
 		}
 
-		if resultArr.Value(0) != float64(expected) {
-			t.Errorf("expected sum %d, got %f", expected, resultArr.Value(0))
+		if resultArr.Value(0) != expected {
+			t.Errorf("expected sum %d, got %d", expected, resultArr.Value(0))
+		}
+	})
+
+	t.Run("int64 sum beyond 2^53", func(t *testing.T) {
+		builder := array.NewInt64Builder(mem)
+		defer builder.Release()
+
+		// Append values whose total needs more than the 53-bit float mantissa
+		builder.AppendValues([]int64{1 << 53, 1, 1}, nil)
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		// Create a series
+		s := NewSeries("large_int64", arr)
+		defer s.Release()
+
+		// Calculate sum
+		result, err := s.Sum()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		// The sum must be exact, not rounded through float64
+		resultArr := result.array.(*array.Int64)
+		if resultArr.Value(0) != 1<<53+2 {
+			t.Errorf("expected sum %d, got %d", int64(1<<53+2), resultArr.Value(0))
+		}
+	})
+
+	t.Run("int64 overflow promotes to decimal", func(t *testing.T) {
+		builder := array.NewInt64Builder(mem)
+		defer builder.Release()
+
+		// Append values whose total does not fit in int64
+		builder.AppendValues([]int64{math.MaxInt64, math.MaxInt64, 2}, nil)
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		// Create a series
+		s := NewSeries("overflow_int64", arr)
+		defer s.Release()
+
+		// Calculate sum
+		result, err := s.Sum()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		// Check result type and value
+		resultArr, ok := result.array.(*array.Decimal128)
+		if !ok {
+			t.Fatalf("expected Decimal128 result, got %s", result.DType())
+		}
+		expected := "18446744073709551616"
+		if resultArr.ValueStr(0) != expected {
+			t.Errorf("expected sum %s, got %s", expected, resultArr.ValueStr(0))
+		}
+	})
+
+	t.Run("int64 negative overflow promotes to decimal", func(t *testing.T) {
+		builder := array.NewInt64Builder(mem)
+		defer builder.Release()
+
+		// Append values whose total is below the int64 range
+		builder.AppendValues([]int64{math.MinInt64, -1, 5, math.MinInt64}, nil)
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		// Create a series
+		s := NewSeries("negative_overflow_int64", arr)
+		defer s.Release()
+
+		// Calculate sum
+		result, err := s.Sum()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		// Check result type and value
+		resultArr, ok := result.array.(*array.Decimal128)
+		if !ok {
+			t.Fatalf("expected Decimal128 result, got %s", result.DType())
+		}
+		expected := "-18446744073709551612"
+		if resultArr.ValueStr(0) != expected {
+			t.Errorf("expected sum %s, got %s", expected, resultArr.ValueStr(0))
+		}
+	})
+
+	t.Run("uint64 overflow promotes to decimal", func(t *testing.T) {
+		builder := array.NewUint64Builder(mem)
+		defer builder.Release()
+
+		// Append values whose total does not fit in uint64
+		builder.AppendValues([]uint64{math.MaxUint64, 1}, nil)
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		// Create a series
+		s := NewSeries("overflow_uint64", arr)
+		defer s.Release()
+
+		// Calculate sum
+		result, err := s.Sum()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		// Check result type and value
+		resultArr, ok := result.array.(*array.Decimal128)
+		if !ok {
+			t.Fatalf("expected Decimal128 result, got %s", result.DType())
+		}
+		expected := "18446744073709551616"
+		if resultArr.ValueStr(0) != expected {
+			t.Errorf("expected sum %s, got %s", expected, resultArr.ValueStr(0))
+		}
+	})
+
+	t.Run("int64 overflow policies", func(t *testing.T) {
+		builder := array.NewInt64Builder(mem)
+		defer builder.Release()
+
+		// Append values whose total does not fit in int64
+		builder.AppendValues([]int64{math.MaxInt64, 10}, nil)
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		// Create a series
+		s := NewSeries("overflow_policy", arr)
+		defer s.Release()
+
+		// Error policy must fail
+		_, err := s.Sum(WithOverflowPolicy(utils.OverflowError))
+		if err == nil {
+			t.Fatalf("expected overflow error, got nil")
+		}
+
+		// Wrap and Saturate keep the Int64 result type
+		testCases := []struct {
+			policy   utils.OverflowPolicy
+			expected int64
+		}{
+			{utils.OverflowWrap, math.MinInt64 + 9},
+			{utils.OverflowSaturate, math.MaxInt64},
+		}
+		for _, tc := range testCases {
+			result, err := s.Sum(WithOverflowPolicy(tc.policy))
+			if err != nil {
+				t.Fatalf("unexpected error with %s policy: %v", tc.policy, err)
+			}
+
+			resultArr := result.array.(*array.Int64)
+			if resultArr.Value(0) != tc.expected {
+				t.Errorf("expected sum %d with %s policy, got %d", tc.expected, tc.policy, resultArr.Value(0))
+			}
+			result.Release()
 		}
 	})
 
-	// Note: Overflow tests have been removed since the Sum function now returns 64-bit types,
-	// which can handle much larger values without overflow.
+	t.Run("uint64 overflow saturates", func(t *testing.T) {
+		builder := array.NewUint64Builder(mem)
+		defer builder.Release()
+
+		// Append values whose total does not fit in uint64
+		builder.AppendValues([]uint64{math.MaxUint64, 10}, nil)
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		// Create a series
+		s := NewSeries("overflow_policy", arr)
+		defer s.Release()
+
+		result, err := s.Sum(WithOverflowPolicy(utils.OverflowSaturate))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		resultArr := result.array.(*array.Uint64)
+		if resultArr.Value(0) != math.MaxUint64 {
+			t.Errorf("expected sum %d, got %d", uint64(math.MaxUint64), resultArr.Value(0))
+		}
+	})
 
 	// Unsupported type
 	t.Run("unsupported type", func(t *testing.T) {
@@ -483,4 +669,292 @@ func TestSeries_Sum(t *testing.T) {
 			t.Errorf("expected error message to contain %q, got: %v", expectedType, err)
 		}
 	})
+	t.Run("concurrent sum returns chunk error", func(t *testing.T) {
+		builder := array.NewBooleanBuilder(mem)
+		defer builder.Release()
+
+		// Append enough values to take the concurrent path
+		n := ConcurrentSumThreshold + 1
+		values := make([]bool, n)
+		builder.AppendValues(values, nil)
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		// Create a series
+		s := NewSeries("concurrent_boolean", arr)
+		defer s.Release()
+
+		// Calculate sum - the chunk error must be returned instead of panicking
+		_, err := s.Sum()
+		if err == nil {
+			t.Fatalf("expected unsupported type error, got nil")
+		}
+
+		expectedType := arrow.FixedWidthTypes.Boolean.String()
+		if !strings.Contains(err.Error(), expectedType) {
+			t.Errorf("expected error message to contain %q, got: %v", expectedType, err)
+		}
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		builder := array.NewInt64Builder(mem)
+		defer builder.Release()
+
+		// Append enough values to take the concurrent path
+		n := ConcurrentSumThreshold + 1
+		builder.AppendValues(make([]int64, n), nil)
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		// Create a series
+		s := NewSeries("cancelled", arr)
+		defer s.Release()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		// Calculate sum with a cancelled context - must return the context error
+		_, err := s.SumCtx(ctx)
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	})
+
+	t.Run("nullable slice with unaligned offset", func(t *testing.T) {
+		builder := array.NewInt64Builder(mem)
+		defer builder.Release()
+
+		// Mix all-valid words, all-null words and mixed words in the validity bitmap
+		const size = 1000
+		values := make([]int64, size)
+		valid := make([]bool, size)
+		for i := 0; i < size; i++ {
+			values[i] = int64(i)
+			switch {
+			case i < 200:
+				valid[i] = true
+			case i < 400:
+				valid[i] = false
+			default:
+				valid[i] = i%3 != 0
+			}
+			if !valid[i] {
+				values[i] = 1_000_000
+			}
+		}
+
+		builder.AppendValues(values, valid)
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		// Slice at an offset that is not a multiple of 8
+		const offset = 13
+		sliced := array.NewSlice(arr, offset, size-5)
+		defer sliced.Release()
+
+		s := NewSeries("sliced", sliced)
+		defer s.Release()
+
+		result, err := s.Sum()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		var expected int64
+		for i := offset; i < size-5; i++ {
+			if valid[i] {
+				expected += values[i]
+			}
+		}
+
+		resultArr := result.array.(*array.Int64)
+		if resultArr.Value(0) != expected {
+			t.Errorf("expected sum %d, got %d", expected, resultArr.Value(0))
+		}
+	})
+
+	t.Run("precise float sum", func(t *testing.T) {
+		// 1 is lost when added to 1e16 naively; spans several precise chunks
+		const triples = 50_000
+		builder := array.NewFloat64Builder(mem)
+		defer builder.Release()
+
+		for i := 0; i < triples; i++ {
+			builder.AppendValues([]float64{1e16, 1, -1e16}, nil)
+		}
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		s := NewSeries("test_precise", arr)
+		defer s.Release()
+
+		var first float64
+		for run := 0; run < 3; run++ {
+			result, err := s.Sum(WithSumMode(utils.SumPrecise))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := result.array.(*array.Float64).Value(0)
+			result.Release()
+
+			if got != triples {
+				t.Errorf("expected sum %d, got %f", triples, got)
+			}
+			if run == 0 {
+				first = got
+			} else if math.Float64bits(got) != math.Float64bits(first) {
+				t.Errorf("expected bit-identical sums across runs, got %v and %v", first, got)
+			}
+		}
+	})
+
+	t.Run("nan policies", func(t *testing.T) {
+		builder := array.NewFloat64Builder(mem)
+		defer builder.Release()
+
+		builder.AppendValues([]float64{1.5, math.NaN(), 2.5, 0}, []bool{true, true, true, false})
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		s := NewSeries("test_nan", arr)
+		defer s.Release()
+
+		propagated, err := s.Sum()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer propagated.Release()
+
+		if v := propagated.array.(*array.Float64).Value(0); !math.IsNaN(v) {
+			t.Errorf("expected NaN with the propagate policy, got %f", v)
+		}
+
+		skipped, err := s.Sum(WithNaNPolicy(utils.NaNSkip))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer skipped.Release()
+
+		if v := skipped.array.(*array.Float64).Value(0); v != 4 {
+			t.Errorf("expected sum 4 with the skip policy, got %f", v)
+		}
+
+		_, err = s.Sum(WithNaNPolicy(utils.NaNError))
+		if err == nil || !strings.Contains(err.Error(), "nan value detected") {
+			t.Errorf("expected nan error, got %v", err)
+		}
+	})
+
+	t.Run("nan skip honours the sum mode", func(t *testing.T) {
+		builder := array.NewFloat64Builder(mem)
+		defer builder.Release()
+
+		builder.AppendValues([]float64{1e16, 1, math.NaN(), -1e16}, nil)
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		s := NewSeries("test_nan_mode", arr)
+		defer s.Release()
+
+		fast, err := s.SumValue(WithNaNPolicy(utils.NaNSkip))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// The 1 is absorbed by 1e16 without compensation
+		if v, err := fast.Float64(); err != nil || v != 0 {
+			t.Errorf("expected fast sum 0, got %f (%v)", v, err)
+		}
+
+		precise, err := s.SumValue(WithNaNPolicy(utils.NaNSkip), WithSumMode(utils.SumPrecise))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if v, err := precise.Float64(); err != nil || v != 1 {
+			t.Errorf("expected precise sum 1, got %f (%v)", v, err)
+		}
+	})
+
+	t.Run("infinite float sum", func(t *testing.T) {
+		builder := array.NewFloat64Builder(mem)
+		defer builder.Release()
+
+		builder.AppendValues([]float64{math.Inf(1), 1}, nil)
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		s := NewSeries("test_inf", arr)
+		defer s.Release()
+
+		result, err := s.Sum(WithSumMode(utils.SumPrecise))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		if v := result.array.(*array.Float64).Value(0); !math.IsInf(v, 1) {
+			t.Errorf("expected +Inf, got %f", v)
+		}
+	})
+
+	t.Run("decimal sum", func(t *testing.T) {
+		prices, err := FromSliceWithValidity("test_price", []string{"0.10", "0.20", "", "1.05"}, []bool{true, true, false, true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer prices.Release()
+
+		s, err := prices.Cast(Decimal128, WithDecimal(5, 2))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer s.Release()
+
+		result, err := s.Sum()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		expectedType := &arrow.Decimal128Type{Precision: 38, Scale: 2}
+		if !arrow.TypeEqual(result.DType(), expectedType) {
+			t.Errorf("expected type %s, got %s", expectedType, result.DType())
+		}
+		if v := result.array.(*array.Decimal128).Value(0).ToString(2); v != "1.35" {
+			t.Errorf("expected sum 1.35, got %s", v)
+		}
+	})
+
+	t.Run("decimal128 overflow policies", func(t *testing.T) {
+		nines := strings.Repeat("9", 38)
+		values := FromSlice("test_decimal", []string{nines, nines, "1"})
+		defer values.Release()
+
+		s, err := values.Cast(Decimal128)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer s.Release()
+
+		promoted, err := s.SumValue()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if promoted.DType().ID() != arrow.DECIMAL256 || promoted.String() != "1"+nines {
+			t.Errorf("expected the exact Decimal256 sum, got %s %s", promoted.DType(), promoted)
+		}
+
+		saturated, err := s.SumValue(WithOverflowPolicy(utils.OverflowSaturate))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if saturated.DType().ID() != arrow.DECIMAL128 || saturated.String() != nines {
+			t.Errorf("expected the saturated Decimal128 sum, got %s %s", saturated.DType(), saturated)
+		}
+
+		if _, err := s.SumValue(WithOverflowPolicy(utils.OverflowError)); err == nil {
+			t.Errorf("expected overflow error, got nil")
+		}
+	})
 }
@@ -0,0 +1,155 @@
+package series
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	arrowArray "github.com/apache/arrow-go/v18/arrow/array"
+
+	"github.com/SHIMA0111/gleam/internal/compute/array"
+)
+
+// Unique returns the distinct values of the Series as a single-chunk Series, null being one of them.
+// The values are in order of first appearance if maintainOrder is true, and sorted ascending with null last otherwise.
+// Float values are distinct by value, so 0 and -0 are one value, as are all NaN values.
+// Returns an error if the values cannot be hashed, e.g. List or Struct.
+func (s *Series) Unique(maintainOrder bool) (*Series, error) {
+	return s.UniqueCtx(context.Background(), maintainOrder)
+}
+
+// UniqueCtx is Unique with a caller-provided context.
+func (s *Series) UniqueCtx(ctx context.Context, maintainOrder bool) (*Series, error) {
+	values, first, _, err := s.distinct(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer values.Release()
+
+	unique, err := values.takePositions(ctx, first)
+	if err != nil {
+		return nil, err
+	}
+	if maintainOrder {
+		return unique, nil
+	}
+	defer unique.Release()
+
+	return unique.SortCtx(ctx)
+}
+
+// NUnique returns the number of distinct values of the Series, counting null as a value, see Unique.
+func (s *Series) NUnique() (int, error) {
+	return s.NUniqueCtx(context.Background())
+}
+
+// NUniqueCtx is NUnique with a caller-provided context.
+func (s *Series) NUniqueCtx(ctx context.Context) (int, error) {
+	values, first, _, err := s.distinct(ctx)
+	if err != nil {
+		return 0, err
+	}
+	values.Release()
+
+	return len(first), nil
+}
+
+// ValueCounts returns the distinct values of the Series, null being one of them, and how many times each appears,
+// as two Series of the same length: the values, named after the Series, and an Int64 Series named "count".
+// If normalize is true, the counts are instead the Float64 share of the Series length, named "proportion".
+// The values are in order of first appearance, or by descending count if sort is true, equal counts keeping
+// the order of first appearance. See dataframe.ValueCounts for the counts as a DataFrame.
+func (s *Series) ValueCounts(sort, normalize bool) (values *Series, counts *Series, err error) {
+	return s.ValueCountsCtx(context.Background(), sort, normalize)
+}
+
+// ValueCountsCtx is ValueCounts with a caller-provided context.
+func (s *Series) ValueCountsCtx(ctx context.Context, sort, normalize bool) (values *Series, counts *Series, err error) {
+	all, first, occurrences, err := s.distinct(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer all.Release()
+
+	if sort {
+		order := make([]int, len(first))
+		for k := range order {
+			order[k] = k
+		}
+		slices.SortStableFunc(order, func(a, b int) int {
+			return cmp.Compare(occurrences[b], occurrences[a])
+		})
+
+		sortedFirst := make([]int64, len(order))
+		sortedOccurrences := make([]int64, len(order))
+		for k, entry := range order {
+			sortedFirst[k] = first[entry]
+			sortedOccurrences[k] = occurrences[entry]
+		}
+		first, occurrences = sortedFirst, sortedOccurrences
+	}
+
+	values, err = all.takePositions(ctx, first)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var countArr arrow.Array
+	if normalize {
+		builder := arrowArray.NewFloat64Builder(s.mem)
+		defer builder.Release()
+		builder.Reserve(len(occurrences))
+		for _, occurrence := range occurrences {
+			builder.UnsafeAppend(float64(occurrence) / float64(s.Len()))
+		}
+		countArr = builder.NewArray()
+	} else {
+		builder := arrowArray.NewInt64Builder(s.mem)
+		defer builder.Release()
+		builder.AppendValues(occurrences, nil)
+		countArr = builder.NewArray()
+	}
+
+	name := "count"
+	if normalize {
+		name = "proportion"
+	}
+
+	return values, newChunkedSeries(name, []arrow.Array{countArr}, countArr.DataType(), s.mem), nil
+}
+
+// distinct returns the Series as a single chunk, with the positions of the first appearances of its
+// distinct values in it and the number of times each appears. The caller releases the single-chunk Series.
+func (s *Series) distinct(ctx context.Context) (values *Series, first []int64, counts []int64, err error) {
+	// The positions span the chunks, so they are hashed as one array
+	values, err = s.RechunkCtx(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	first, counts, err = array.Distinct(ctx, values.array, s.reduceChunkSize())
+	if err != nil {
+		values.Release()
+		return nil, nil, nil, err
+	}
+
+	return values, first, counts, nil
+}
+
+// takePositions returns the elements of the single-chunk Series at the positions.
+func (s *Series) takePositions(ctx context.Context, positions []int64) (*Series, error) {
+	builder := arrowArray.NewInt64Builder(s.mem)
+	defer builder.Release()
+	builder.AppendValues(positions, nil)
+
+	indices := builder.NewArray()
+	defer indices.Release()
+
+	taken, err := array.Take(ctx, s.array, indices)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.withChunks([]arrow.Array{taken}), nil
+}
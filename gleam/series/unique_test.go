@@ -0,0 +1,187 @@
+package series
+
+import (
+	"math"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+)
+
+func TestSeries_Unique(t *testing.T) {
+	t.Run("order of first appearance and sorted", func(t *testing.T) {
+		s := newChunkedInt64(t, []int64{3, 1, 0}, []int64{3, 2, 1, 0})
+		defer s.Release()
+
+		unique, err := s.Unique(true)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer unique.Release()
+
+		values, valid, err := Values[int64](unique)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(values) != 4 || values[0] != 3 || values[1] != 1 || valid[2] || values[3] != 2 {
+			t.Errorf("expected [3 1 null 2], got %s", unique)
+		}
+
+		sorted, err := s.Unique(false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer sorted.Release()
+
+		values, valid, err = Values[int64](sorted)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(values) != 4 || values[0] != 1 || values[1] != 2 || values[2] != 3 || valid[3] {
+			t.Errorf("expected [1 2 3 null], got %s", sorted)
+		}
+
+		n, err := s.NUnique()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n != 4 {
+			t.Errorf("expected 4 distinct values, got %d", n)
+		}
+	})
+
+	t.Run("floats are distinct by value", func(t *testing.T) {
+		s := FromSlice("test_float64", []float64{0, math.Copysign(0, -1), math.NaN(), math.NaN(), 1.5})
+		defer s.Release()
+
+		n, err := s.NUnique()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n != 3 {
+			t.Errorf("expected 3 distinct values, got %d", n)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		ints := FromSlice("test_int64", []int64{})
+		defer ints.Release()
+		timestamps, err := ints.Cast(Timestamp)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer timestamps.Release()
+		decimals, err := ints.Cast(Decimal128, WithDecimal(10, 2))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer decimals.Release()
+
+		for _, s := range []*Series{ints, timestamps, decimals} {
+			unique, err := s.Unique(false)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", s.DType(), err)
+			}
+			if unique.Len() != 0 {
+				t.Errorf("%s: expected no values, got %s", s.DType(), unique)
+			}
+			unique.Release()
+
+			if n, err := s.NUnique(); err != nil || n != 0 {
+				t.Errorf("%s: expected 0 distinct values, got %d (%v)", s.DType(), n, err)
+			}
+
+			values, counts, err := s.ValueCounts(true, true)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", s.DType(), err)
+			}
+			if values.Len() != 0 || counts.Len() != 0 {
+				t.Errorf("%s: expected no counts, got %s and %s", s.DType(), values, counts)
+			}
+			values.Release()
+			counts.Release()
+		}
+	})
+
+	t.Run("unhashable type", func(t *testing.T) {
+		s := FromListSlice("test_tags", [][]string{{"a"}})
+		defer s.Release()
+
+		if _, err := s.NUnique(); err == nil {
+			t.Errorf("expected unsupported type error, got nil")
+		}
+	})
+}
+
+func TestSeries_ValueCounts(t *testing.T) {
+	t.Run("counts in order of first appearance", func(t *testing.T) {
+		s, err := FromSliceWithValidity("test_string", []string{"b", "a", "", "a", ""}, []bool{true, true, false, true, false})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer s.Release()
+
+		values, counts, err := s.ValueCounts(false, false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer values.Release()
+		defer counts.Release()
+
+		valueArr := values.array.(*array.String)
+		countArr := counts.array.(*array.Int64)
+		if values.Name() != "test_string" || counts.Name() != "count" {
+			t.Errorf("expected columns test_string and count, got %s and %s", values.Name(), counts.Name())
+		}
+		if valueArr.Value(0) != "b" || valueArr.Value(1) != "a" || !valueArr.IsNull(2) ||
+			countArr.Value(0) != 1 || countArr.Value(1) != 2 || countArr.Value(2) != 2 {
+			t.Errorf("expected [b a null] with counts [1 2 2], got %s and %s", valueArr, countArr)
+		}
+	})
+
+	t.Run("sorted and normalized", func(t *testing.T) {
+		s := FromSlice("test_string", []string{"b", "a", "c", "a", "c"})
+		defer s.Release()
+
+		values, counts, err := s.ValueCounts(true, true)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer values.Release()
+		defer counts.Release()
+
+		valueArr := values.array.(*array.String)
+		countArr := counts.array.(*array.Float64)
+		if counts.Name() != "proportion" {
+			t.Errorf("expected the proportion column, got %s", counts.Name())
+		}
+		if valueArr.Value(0) != "a" || valueArr.Value(1) != "c" || valueArr.Value(2) != "b" ||
+			countArr.Value(0) != 0.4 || countArr.Value(2) != 0.2 {
+			t.Errorf("expected [a c b] with proportions [0.4 0.4 0.2], got %s and %s", valueArr, countArr)
+		}
+	})
+
+	t.Run("categorical", func(t *testing.T) {
+		strs := FromSlice("test_category", []string{"x", "y", "x"})
+		defer strs.Release()
+		categories, err := strs.Cast(Categorical)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer categories.Release()
+
+		values, counts, err := categories.ValueCounts(true, false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer values.Release()
+		defer counts.Release()
+
+		if values.DType().ID() != arrow.DICTIONARY || values.Len() != 2 {
+			t.Errorf("expected 2 categories, got %s", values)
+		}
+		if v := counts.array.(*array.Int64).Value(0); v != 2 {
+			t.Errorf("expected x to appear twice, got %d", v)
+		}
+	})
+}
@@ -0,0 +1,171 @@
+package series
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+)
+
+// Values copies the elements of the Series into a Go slice, together with its validity mask:
+// the element i is null where valid[i] is false, and holds the zero value of T.
+// The mask is nil if the Series has no nulls. T must match the data type of the Series:
+// the numeric type of the same name for a numeric Series (e.g. int32 for Int32), string for String and LargeString,
+// []byte for Binary and LargeBinary, and bool for Boolean. Other data types return an error.
+func Values[T Element](s *Series) (values []T, valid []bool, err error) {
+	values = make([]T, s.Len())
+	if s.NullCount() > 0 {
+		valid = make([]bool, s.Len())
+	}
+
+	for c, chunk := range s.chunks {
+		offset := s.offsets[c]
+		if !chunkValues(chunk, values[offset:offset+chunk.Len()]) {
+			return nil, nil, valuesTypeError(s, values)
+		}
+		if valid == nil {
+			continue
+		}
+
+		var zero T
+		for i := 0; i < chunk.Len(); i++ {
+			valid[offset+i] = chunk.IsValid(i)
+			if !valid[offset+i] {
+				// The buffer under a null slot holds an undefined value
+				values[offset+i] = zero
+			}
+		}
+	}
+
+	return values, valid, nil
+}
+
+// chunkValues copies the elements of the chunk into values, which has the length of the chunk.
+// Returns false if T is not the Go type of the chunk.
+func chunkValues[T Element](chunk arrow.Array, values []T) bool {
+	switch out := any(values).(type) {
+	case []string:
+		var arr interface{ Value(int) string }
+		switch a := chunk.(type) {
+		case *array.String:
+			arr = a
+		case *array.LargeString:
+			arr = a
+		default:
+			return false
+		}
+		for i := range out {
+			// Value points into the arrow buffer, so the string is copied out of it
+			out[i] = strings.Clone(arr.Value(i))
+		}
+	case [][]byte:
+		var arr interface{ Value(int) []byte }
+		switch a := chunk.(type) {
+		case *array.Binary:
+			arr = a
+		case *array.LargeBinary:
+			arr = a
+		default:
+			return false
+		}
+		for i := range out {
+			// The bytes are copied out of the arrow buffer too, and a null element stays nil
+			if chunk.IsValid(i) {
+				out[i] = bytes.Clone(arr.Value(i))
+			}
+		}
+	case []bool:
+		arr, ok := chunk.(*array.Boolean)
+		if !ok {
+			return false
+		}
+		for i := range out {
+			out[i] = arr.Value(i)
+		}
+	default:
+		view, ok := primitiveValues[T](chunk)
+		if !ok {
+			return false
+		}
+		copy(values, view)
+	}
+
+	return true
+}
+
+// ValuesPtr copies the elements of the Series into a Go slice of pointers, where a null element is a nil pointer.
+// T must match the data type of the Series, as for Values.
+func ValuesPtr[T Element](s *Series) ([]*T, error) {
+	values, valid, err := Values[T](s)
+	if err != nil {
+		return nil, err
+	}
+
+	ptrs := make([]*T, len(values))
+	for i := range values {
+		if valid == nil || valid[i] {
+			ptrs[i] = &values[i]
+		}
+	}
+
+	return ptrs, nil
+}
+
+// ValuesView returns the elements of a numeric Series without nulls as a slice over the arrow buffer, without copying.
+// The slice is only valid until the Series is released and must not be modified.
+// Returns an error if T does not match the data type of the Series, if the Series has nulls or if it has several chunks.
+func ValuesView[T NumericElement](s *Series) ([]T, error) {
+	chunk, err := s.singleChunk("view")
+	if err != nil {
+		return nil, err
+	}
+
+	view, ok := primitiveValues[T](chunk)
+	if !ok {
+		return nil, valuesTypeError(s, view)
+	}
+	if s.NullCount() > 0 {
+		return nil, fmt.Errorf("cannot view Series %q with %d nulls, use Values instead", s.name, s.NullCount())
+	}
+
+	return view, nil
+}
+
+// primitiveValues returns the values of a fixed-width array as a slice over its buffer, starting at the array offset.
+// ok is false if T is not the Go type of the array.
+func primitiveValues[T Element](arr arrow.Array) (values []T, ok bool) {
+	var view any
+	switch a := arr.(type) {
+	case *array.Int8:
+		view = a.Int8Values()
+	case *array.Int16:
+		view = a.Int16Values()
+	case *array.Int32:
+		view = a.Int32Values()
+	case *array.Int64:
+		view = a.Int64Values()
+	case *array.Uint8:
+		view = a.Uint8Values()
+	case *array.Uint16:
+		view = a.Uint16Values()
+	case *array.Uint32:
+		view = a.Uint32Values()
+	case *array.Uint64:
+		view = a.Uint64Values()
+	case *array.Float32:
+		view = a.Float32Values()
+	case *array.Float64:
+		view = a.Float64Values()
+	default:
+		return nil, false
+	}
+
+	values, ok = view.([]T)
+	return values, ok
+}
+
+func valuesTypeError[T Element](s *Series, values []T) error {
+	return fmt.Errorf("cannot read %s Series as %T", s.DType(), values)
+}
@@ -0,0 +1,165 @@
+package series
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow/array"
+)
+
+func TestValues(t *testing.T) {
+	t.Run("sliced nullable values", func(t *testing.T) {
+		s, err := FromSliceWithValidity(
+			"test_int64",
+			[]int64{1, 2, 3, 4, 5, 6},
+			[]bool{true, true, false, true, true, true},
+		)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer s.Release()
+
+		slicedArr := array.NewSlice(s.array, 1, 5)
+		defer slicedArr.Release()
+
+		sliced := NewSeries("test_sliced", slicedArr)
+		defer sliced.Release()
+
+		values, valid, err := Values[int64](sliced)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if expected := []int64{2, 0, 4, 5}; !slices.Equal(values, expected) {
+			t.Errorf("expected values %v, got %v", expected, values)
+		}
+		if expected := []bool{true, false, true, true}; !slices.Equal(valid, expected) {
+			t.Errorf("expected validity %v, got %v", expected, valid)
+		}
+	})
+
+	t.Run("strings without nulls", func(t *testing.T) {
+		s := FromSlice("test_string", []string{"a", "bc"})
+		defer s.Release()
+
+		values, valid, err := Values[string](s)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !slices.Equal(values, []string{"a", "bc"}) {
+			t.Errorf("expected [a bc], got %v", values)
+		}
+		if valid != nil {
+			t.Errorf("expected nil validity for a Series without nulls, got %v", valid)
+		}
+	})
+
+	t.Run("large strings and binaries", func(t *testing.T) {
+		s, err := FromSliceWithValidity("test_hash", []string{"ab", "", "cd"}, []bool{true, false, true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer s.Release()
+
+		large, err := s.Cast(LargeString)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer large.Release()
+
+		strs, valid, err := Values[string](large)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !slices.Equal(strs, []string{"ab", "", "cd"}) || !slices.Equal(valid, []bool{true, false, true}) {
+			t.Errorf("expected [ab null cd], got %v with validity %v", strs, valid)
+		}
+
+		for _, dtype := range []DataType{Binary, LargeBinary} {
+			binary, err := s.Cast(dtype)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			values, valid, err := Values[[]byte](binary)
+			binaryType := binary.DType()
+			binary.Release()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(values) != 3 || string(values[0]) != "ab" || values[1] != nil || string(values[2]) != "cd" {
+				t.Errorf("expected [ab nil cd] from %s, got %q", binaryType, values)
+			}
+			if !slices.Equal(valid, []bool{true, false, true}) {
+				t.Errorf("expected validity [true false true] from %s, got %v", binaryType, valid)
+			}
+		}
+
+		if _, _, err := Values[[]byte](s); err == nil {
+			t.Errorf("expected type mismatch error reading a String Series as []byte, got nil")
+		}
+	})
+
+	t.Run("pointer values", func(t *testing.T) {
+		first := true
+		s := FromPtrSlice("test_bool", []*bool{&first, nil})
+		defer s.Release()
+
+		ptrs, err := ValuesPtr[bool](s)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(ptrs) != 2 || ptrs[0] == nil || !*ptrs[0] || ptrs[1] != nil {
+			t.Errorf("expected [true nil], got %v", ptrs)
+		}
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+		s := FromSlice("test_int32", []int32{1})
+		defer s.Release()
+
+		if _, _, err := Values[int64](s); err == nil {
+			t.Errorf("expected type mismatch error, got nil")
+		}
+		if _, err := ValuesView[float32](s); err == nil {
+			t.Errorf("expected type mismatch error, got nil")
+		}
+	})
+
+	t.Run("zero-copy view", func(t *testing.T) {
+		s := FromSlice("test_float64", []float64{1.5, 2.5, 3.5})
+		defer s.Release()
+
+		slicedArr := array.NewSlice(s.array, 1, 3)
+		defer slicedArr.Release()
+
+		sliced := NewSeries("test_sliced", slicedArr)
+		defer sliced.Release()
+
+		view, err := ValuesView[float64](sliced)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !slices.Equal(view, []float64{2.5, 3.5}) {
+			t.Errorf("expected [2.5 3.5], got %v", view)
+		}
+		if &view[0] != &s.array.(*array.Float64).Float64Values()[1] {
+			t.Errorf("expected the view to share the arrow buffer")
+		}
+	})
+
+	t.Run("view with nulls", func(t *testing.T) {
+		s, err := FromSliceWithValidity("test_nulls", []uint8{1, 2}, []bool{true, false})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer s.Release()
+
+		if _, err := ValuesView[uint8](s); err == nil {
+			t.Errorf("expected error for a Series with nulls, got nil")
+		}
+	})
+}
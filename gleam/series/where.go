@@ -1,56 +1,150 @@
 package series
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/apache/arrow-go/v18/arrow"
+	arrowArray "github.com/apache/arrow-go/v18/arrow/array"
 	"github.com/apache/arrow-go/v18/arrow/compute"
+	"github.com/apache/arrow-go/v18/arrow/memory"
 	"github.com/apache/arrow-go/v18/arrow/scalar"
 
 	"github.com/SHIMA0111/gleam/gleam/utils"
 	"github.com/SHIMA0111/gleam/internal/compute/array"
+	internalUtils "github.com/SHIMA0111/gleam/internal/utils"
 )
 
 type ComparisonArray arrow.Array
 
 // Where filters the Series based on the given CompareOperand and value, returning a new Series with matched elements.
+// The value is a Go value, see Comparison, or a *Series of the same length compared element-wise, see ComparisonSeries.
 func (s *Series) Where(cond utils.CompareOperand, val interface{}) (*Series, error) {
-	// Create context
-	ctx := context.Background()
-
-	filterArray, err := s.Comparison(cond, val)
-	if err != nil {
-		return nil, err
-	}
-	defer filterArray.Release()
+	return s.WhereCtx(context.Background(), cond, val)
+}
 
+// WhereCtx is Where with a caller-provided context.
+func (s *Series) WhereCtx(ctx context.Context, cond utils.CompareOperand, val interface{}) (*Series, error) {
 	// FilterOptions: currently using the default options
 	filterOpts := compute.DefaultFilterOptions()
 
-	resultArray, err := array.Filter(ctx, s.array, filterArray, *filterOpts)
+	if other, ok := val.(*Series); ok {
+		filterArray, err := s.ComparisonSeriesCtx(ctx, cond, other)
+		if err != nil {
+			return nil, err
+		}
+		defer filterArray.Release()
+
+		// The comparison array is contiguous, so the Series is filtered as one chunk
+		source, err := s.RechunkCtx(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer source.Release()
+
+		filtered, err := array.Filter(ctx, source.array, filterArray, *filterOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.withChunks([]arrow.Array{filtered}), nil
+	}
+
+	scl, err := makeScalarFor(val, s.DType())
 	if err != nil {
 		return nil, err
 	}
-	defer resultArray.Release()
 
-	// Create Series by the filtered array with the input series name
-	resultSeries := NewSeries(s.name, resultArray)
+	// Every chunk is filtered by its own comparison, so the result keeps the chunks of the Series
+	return s.mapChunks(func(chunk arrow.Array) (arrow.Array, error) {
+		filterArray, err := array.Comparison(ctx, chunk, cond, scl)
+		if err != nil {
+			return nil, err
+		}
+		defer filterArray.Release()
 
-	return resultSeries, nil
+		return array.Filter(ctx, chunk, filterArray, *filterOpts)
+	})
 }
 
 // Comparison performs element-wise comparison on the Series using the specified condition and value, returning a bitmap array.
 // The method takes a CompareOperand and value as parameters and returns an arrow.Array or an error if the operation fails.
+// A time.Time value compares with a Date32, Timestamp or Time64 Series by its date, instant or time of day,
+// and a time.Duration value with a Duration Series. A decimal Series compares exactly with integers and with
+// decimal literals given as strings such as "19.99"; float values compare approximately.
+// A Categorical Series compares once per category and gathers the results by the element codes.
+// A []byte value compares with a Binary, LargeBinary or FixedSizeBinary Series, and with strings by their bytes.
+// The comparison array of a Series with several chunks is one contiguous array.
+// A *Series value is compared element-wise, see ComparisonSeries.
 func (s *Series) Comparison(cond utils.CompareOperand, val interface{}) (ComparisonArray, error) {
-	ctx := context.Background()
-	scl, err := makeScalar(val)
+	return s.ComparisonCtx(context.Background(), cond, val)
+}
+
+// ComparisonCtx is Comparison with a caller-provided context.
+func (s *Series) ComparisonCtx(ctx context.Context, cond utils.CompareOperand, val interface{}) (ComparisonArray, error) {
+	if other, ok := val.(*Series); ok {
+		return s.ComparisonSeriesCtx(ctx, cond, other)
+	}
+
+	scl, err := makeScalarFor(val, s.DType())
+	if err != nil {
+		return nil, err
+	}
+
+	if s.array != nil {
+		return array.Comparison(ctx, s.array, cond, scl)
+	}
+
+	// A comparison array is contiguous, so the comparisons of the chunks are concatenated
+	masks := make([]arrow.Array, 0, len(s.chunks))
+	defer func() {
+		for _, mask := range masks {
+			mask.Release()
+		}
+	}()
+	for _, chunk := range s.chunks {
+		mask, err := array.Comparison(ctx, chunk, cond, scl)
+		if err != nil {
+			return nil, err
+		}
+		masks = append(masks, mask)
+	}
+
+	return arrowArray.Concatenate(masks, s.mem)
+}
+
+// ComparisonSeries compares the elements of the Series with the elements of other at the same positions, e.g.
+// "end_date > start_date", returning a comparison array accepted by Where and DataFrame.Where.
+// Numeric Series of different types are promoted to a common type first, e.g. Int32 and Float64 compare as Float64,
+// and a Categorical Series compares by its values. A null element on either side gives null.
+// Returns an error if the Series differ in length or their types cannot be compared.
+func (s *Series) ComparisonSeries(cond utils.CompareOperand, other *Series) (ComparisonArray, error) {
+	return s.ComparisonSeriesCtx(context.Background(), cond, other)
+}
+
+// ComparisonSeriesCtx is ComparisonSeries with a caller-provided context.
+func (s *Series) ComparisonSeriesCtx(ctx context.Context, cond utils.CompareOperand, other *Series) (ComparisonArray, error) {
+	if other.Len() != s.Len() {
+		return nil, fmt.Errorf("Series %q has length %d, expected %d", other.Name(), other.Len(), s.Len())
+	}
+
+	// The elements are paired by position, so Series chunked differently are paired as single chunks
+	left, err := s.RechunkCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer left.Release()
+
+	right, err := other.RechunkCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer right.Release()
 
-	return array.Comparison(ctx, s.array, cond, scl)
+	return array.ComparisonArrays(ctx, left.array, right.array, cond, s.mem)
 }
 
 // makeScalar translates the input-compared value to the arrow scalar
@@ -92,11 +186,59 @@ func makeScalar(val interface{}) (scalar.Scalar, error) {
 	// string type
 	case string:
 		return scalar.NewStringScalar(v), nil
+	// binary type; the bytes are copied, so the caller can reuse the slice
+	case []byte:
+		return scalar.NewBinaryScalar(memory.NewBufferBytes(bytes.Clone(v)), arrow.BinaryTypes.Binary), nil
 	// boolean type
 	case bool:
 		return scalar.NewBooleanScalar(v), nil
+	// temporal types
+	case time.Time:
+		return scalar.NewTimestampScalar(arrow.Timestamp(v.UnixNano()), arrow.FixedWidthTypes.Timestamp_ns), nil
+	case time.Duration:
+		return scalar.NewDurationScalar(arrow.Duration(v), arrow.FixedWidthTypes.Duration_ns), nil
 	// Unsupported type branch
 	default:
 		return nil, fmt.Errorf("unsupported type: %T", v)
 	}
 }
+
+// makeScalarFor translates the compared value to an arrow scalar for a Series of the data type dtype.
+// Temporal values take the type of the Series, because their arrow type depends on the unit and time zone
+// of the column rather than on the Go type. A string compared with a decimal Series is parsed as an exact decimal literal.
+// Other values are translated by makeScalar.
+func makeScalarFor(val interface{}, dtype arrow.DataType) (scalar.Scalar, error) {
+	// A categorical Series is compared by its decoded values
+	if dictType, ok := dtype.(*arrow.DictionaryType); ok {
+		return makeScalarFor(val, dictType.ValueType)
+	}
+
+	switch v := val.(type) {
+	case time.Time:
+		switch dt := dtype.(type) {
+		case *arrow.TimestampType:
+			ts, err := arrow.TimestampFromTime(v, dt.Unit)
+			if err != nil {
+				return nil, err
+			}
+			return scalar.NewTimestampScalar(ts, dtype), nil
+		case *arrow.Date32Type:
+			return scalar.NewDate32Scalar(internalUtils.Date32Of(v)), nil
+		case *arrow.Time64Type:
+			return scalar.NewTime64Scalar(arrow.Time64(internalUtils.TimeOfDay(v)/dt.Unit.Multiplier()), dtype), nil
+		}
+	case time.Duration:
+		if dt, ok := dtype.(*arrow.DurationType); ok {
+			return scalar.NewDurationScalar(arrow.Duration(v/dt.Unit.Multiplier()), dtype), nil
+		}
+	case string:
+		if array.IsDecimal(dtype) {
+			return array.ParseDecimalScalar(v)
+		}
+		return makeScalar(val)
+	default:
+		return makeScalar(val)
+	}
+
+	return nil, fmt.Errorf("cannot compare %s Series with %T", dtype, val)
+}
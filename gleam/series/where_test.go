@@ -1,9 +1,13 @@
 package series
 
 import (
+	"context"
+	"errors"
 	"github.com/SHIMA0111/gleam/gleam/utils"
+	"math"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
@@ -338,6 +342,112 @@ func TestSeries_Where(t *testing.T) {
 			t.Fatalf("expected unsupported type error, got nil")
 		}
 	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		// Create a builder for int64 values
+		builder := array.NewInt64Builder(mem)
+		defer builder.Release()
+
+		// Append values
+		builder.AppendValues([]int64{1, 2, 3, 4, 5}, nil)
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		// Create a series
+		s := NewSeries("test", arr)
+		defer s.Release()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		// Filter with a cancelled context must return the context error
+		_, err := s.WhereCtx(ctx, utils.Equal, int64(3))
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	})
+
+	t.Run("timestamp greater than time.Time", func(t *testing.T) {
+		dtype := &arrow.TimestampType{Unit: arrow.Second, TimeZone: "Asia/Tokyo"}
+		builder := array.NewTimestampBuilder(mem, dtype)
+		defer builder.Release()
+
+		base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		for i := 0; i < 4; i++ {
+			builder.Append(arrow.Timestamp(base.Add(time.Duration(i) * time.Hour).Unix()))
+		}
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		s := NewSeries("test_timestamp", arr)
+		defer s.Release()
+
+		// The literal is in another zone; the instants are compared
+		tokyo := time.FixedZone("JST", 9*60*60)
+		result, err := s.Where(utils.Greater, time.Date(2024, 1, 1, 10, 0, 0, 0, tokyo))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer result.Release()
+
+		if result.Len() != 2 {
+			t.Errorf("expected 2 elements after 01:00 UTC, got %d", result.Len())
+		}
+	})
+
+	t.Run("date32 equal and duration less", func(t *testing.T) {
+		dates := FromSlice("test_date", []string{"2024-05-01", "2024-05-02"})
+		defer dates.Release()
+
+		dateSeries, err := dates.Cast(Date32)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer dateSeries.Release()
+
+		// Late evening in New York is already the next day in UTC; the calendar date of the literal is used
+		newYork, err := time.LoadLocation("America/New_York")
+		if err != nil {
+			t.Skipf("time zone database unavailable: %v", err)
+		}
+		matched, err := dateSeries.Where(utils.Equal, time.Date(2024, 5, 1, 23, 0, 0, 0, newYork))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer matched.Release()
+
+		if matched.Len() != 1 || matched.array.(*array.Date32).Value(0).ToTime().Day() != 1 {
+			t.Errorf("expected only 2024-05-01 to match, got %s", matched)
+		}
+
+		durations := FromSlice("test_duration", []string{"90s", "30m", "2h"})
+		defer durations.Release()
+
+		durationSeries, err := durations.Cast(Duration, WithTimeUnit(arrow.Millisecond))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer durationSeries.Release()
+
+		short, err := durationSeries.Where(utils.Less, time.Hour)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer short.Release()
+
+		if short.Len() != 2 {
+			t.Errorf("expected 2 durations under an hour, got %d", short.Len())
+		}
+	})
+
+	t.Run("time.Time with non-temporal Series", func(t *testing.T) {
+		s := FromSlice("test_int64", []int64{1})
+		defer s.Release()
+
+		if _, err := s.Where(utils.Equal, time.Now()); err == nil {
+			t.Errorf("expected error comparing an Int64 Series with time.Time, got nil")
+		}
+	})
 }
 
 func TestMakeScalar(t *testing.T) {
@@ -358,6 +468,7 @@ func TestMakeScalar(t *testing.T) {
 		{"float64", float64(1.0), arrow.PrimitiveTypes.Float64},
 		{"string", "test", arrow.BinaryTypes.String},
 		{"bool", true, arrow.FixedWidthTypes.Boolean},
+		{"bytes", []byte("test"), arrow.BinaryTypes.Binary},
 	}
 
 	for _, tc := range testCases {
@@ -415,4 +526,289 @@ func TestMakeScalar(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("decimal compared exactly", func(t *testing.T) {
+		prices := FromSlice("test_price", []string{"19.99", "20.00", "20.01", "5"})
+		defer prices.Release()
+
+		s, err := prices.Cast(Decimal128, WithDecimal(10, 2))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer s.Release()
+
+		// A decimal literal with another scale compares by value
+		above, err := s.Where(utils.Greater, "19.995")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer above.Release()
+
+		if above.Len() != 2 {
+			t.Errorf("expected 2 prices above 19.995, got %d", above.Len())
+		}
+
+		equal, err := s.Where(utils.Equal, int64(20))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer equal.Release()
+
+		if equal.Len() != 1 || equal.array.(*array.Decimal128).Value(0).ToString(2) != "20.00" {
+			t.Errorf("expected only 20.00 to equal 20, got %s", equal)
+		}
+
+		if _, err := s.Where(utils.Equal, "twenty"); err == nil {
+			t.Errorf("expected parse error for a non-numeric literal, got nil")
+		}
+	})
+
+	t.Run("categorical compared by category", func(t *testing.T) {
+		values, err := FromSliceWithValidity("test_status", []string{"open", "closed", "", "open", "pending"}, []bool{true, true, false, true, true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer values.Release()
+
+		s, err := values.Cast(Categorical)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer s.Release()
+
+		open, err := s.Where(utils.Equal, "open")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer open.Release()
+
+		if open.Len() != 2 || !arrow.TypeEqual(open.DType(), s.DType()) {
+			t.Errorf("expected 2 open categorical elements, got %s", open)
+		}
+
+		mask, err := s.Comparison(utils.Greater, "closed")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer mask.Release()
+
+		expected := []bool{true, false, false, true, true}
+		boolMask := mask.(*array.Boolean)
+		for i, v := range expected {
+			if i == 2 {
+				if !boolMask.IsNull(i) {
+					t.Errorf("expected a null comparison for the null element")
+				}
+				continue
+			}
+			if boolMask.Value(i) != v {
+				t.Errorf("at index %d: expected %v, got %v", i, v, boolMask.Value(i))
+			}
+		}
+	})
+
+	t.Run("binary equal to bytes", func(t *testing.T) {
+		values := FromSlice("test_hash", []string{"ab", "cd", "ab"})
+		defer values.Release()
+
+		for _, tt := range []struct {
+			dtype DataType
+			opts  []CastOption
+		}{
+			{Binary, nil},
+			{LargeBinary, nil},
+			{FixedSizeBinary, []CastOption{WithByteWidth(2)}},
+		} {
+			s, err := values.Cast(tt.dtype, tt.opts...)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			key := []byte("ab")
+			result, err := s.Where(utils.Equal, key)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			// The literal was copied, so changing the slice afterwards has no effect
+			key[0] = 'x'
+
+			if result.Len() != 2 {
+				t.Errorf("%s: expected 2 matches, got %d", s.DType(), result.Len())
+			}
+			result.Release()
+			s.Release()
+		}
+
+		// LargeString compares with a string literal as String does
+		large, err := values.Cast(LargeString)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer large.Release()
+
+		notEqual, err := large.Where(utils.NotEqual, "ab")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer notEqual.Release()
+
+		if notEqual.Len() != 1 {
+			t.Errorf("expected 1 mismatch, got %d", notEqual.Len())
+		}
+	})
+}
+
+func TestSeries_ComparisonSeries(t *testing.T) {
+	t.Run("promotes numeric types", func(t *testing.T) {
+		price, err := FromSliceWithValidity("price", []int32{10, 20, 30, 40}, []bool{true, true, false, true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer price.Release()
+		cost := FromSlice("cost", []float64{10.5, 19.5, 1, 40})
+		defer cost.Release()
+
+		mask, err := price.ComparisonSeries(utils.Less, cost)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer mask.Release()
+
+		maskArr := mask.(*array.Boolean)
+		if !maskArr.Value(0) || maskArr.Value(1) || !maskArr.IsNull(2) || maskArr.Value(3) {
+			t.Errorf("expected [true false null false], got %s", maskArr)
+		}
+
+		for _, cond := range []utils.CompareOperand{utils.Equal, utils.NotEqual, utils.Greater, utils.GreaterEqual, utils.Less, utils.LessEqual} {
+			result, err := price.ComparisonSeries(cond, cost)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", cond, err)
+			}
+			result.Release()
+		}
+	})
+
+	t.Run("64-bit integers compare exactly", func(t *testing.T) {
+		signed := FromSlice("signed", []int64{9007199254740993, -1, 9007199254740993, math.MaxInt64})
+		defer signed.Release()
+		unsigned := FromSlice("unsigned", []uint64{9007199254740992, math.MaxUint64, 9007199254740993, math.MaxInt64 + 1})
+		defer unsigned.Release()
+
+		equal, err := signed.ComparisonSeries(utils.Equal, unsigned)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer equal.Release()
+
+		equalArr := equal.(*array.Boolean)
+		if equalArr.Value(0) || equalArr.Value(1) || !equalArr.Value(2) || equalArr.Value(3) {
+			t.Errorf("expected [false false true false], got %s", equalArr)
+		}
+
+		less, err := signed.ComparisonSeries(utils.Less, unsigned)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer less.Release()
+
+		lessArr := less.(*array.Boolean)
+		if lessArr.Value(0) || !lessArr.Value(1) || lessArr.Value(2) || !lessArr.Value(3) {
+			t.Errorf("expected [false true false true], got %s", lessArr)
+		}
+
+		floats := FromSlice("floats", []float64{9007199254740992, -1.5, math.NaN(), 1e19})
+		defer floats.Release()
+
+		greater, err := signed.ComparisonSeries(utils.Greater, floats)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer greater.Release()
+
+		greaterArr := greater.(*array.Boolean)
+		if !greaterArr.Value(0) || !greaterArr.Value(1) || greaterArr.Value(2) || greaterArr.Value(3) {
+			t.Errorf("expected [true true false false], got %s", greaterArr)
+		}
+
+		notEqual, err := floats.ComparisonSeries(utils.NotEqual, unsigned)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer notEqual.Release()
+
+		notEqualArr := notEqual.(*array.Boolean)
+		if notEqualArr.Value(0) || !notEqualArr.Value(1) || !notEqualArr.Value(2) || !notEqualArr.Value(3) {
+			t.Errorf("expected [false true true true], got %s", notEqualArr)
+		}
+	})
+
+	t.Run("chunked Series filter with Where", func(t *testing.T) {
+		start := newChunkedInt64(t, []int64{1, 5}, []int64{3, 4})
+		defer start.Release()
+		end := FromSlice("end", []int64{2, 4, 3, 6})
+		defer end.Release()
+
+		later, err := end.Where(utils.Greater, start)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer later.Release()
+
+		values, _, err := Values[int64](later)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if later.Name() != "end" || len(values) != 2 || values[0] != 2 || values[1] != 6 {
+			t.Errorf("expected [2 6], got %s", later)
+		}
+
+		mask, err := end.Comparison(utils.Greater, start)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer mask.Release()
+
+		if n, err := CountTrue(mask); err != nil || n != 2 {
+			t.Errorf("expected 2 true elements, got %d (%v)", n, err)
+		}
+	})
+
+	t.Run("categorical compares by value", func(t *testing.T) {
+		left := FromSlice("left", []string{"a", "b", "c"})
+		defer left.Release()
+		categories, err := left.Cast(Categorical)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer categories.Release()
+		right := FromSlice("right", []string{"a", "x", "c"})
+		defer right.Release()
+
+		mask, err := categories.ComparisonSeries(utils.Equal, right)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer mask.Release()
+
+		maskArr := mask.(*array.Boolean)
+		if !maskArr.Value(0) || maskArr.Value(1) || !maskArr.Value(2) {
+			t.Errorf("expected [true false true], got %s", maskArr)
+		}
+	})
+
+	t.Run("incompatible Series", func(t *testing.T) {
+		ints := FromSlice("ints", []int64{1, 2})
+		defer ints.Release()
+		short := FromSlice("short", []int64{1})
+		defer short.Release()
+		strs := FromSlice("strs", []string{"a", "b"})
+		defer strs.Release()
+
+		if _, err := ints.ComparisonSeries(utils.Equal, short); err == nil {
+			t.Errorf("expected length mismatch error, got nil")
+		}
+		if _, err := ints.ComparisonSeries(utils.Equal, strs); err == nil {
+			t.Errorf("expected incompatible type error, got nil")
+		}
+	})
 }
@@ -0,0 +1,27 @@
+package utils
+
+// NaNPolicy decides how a float aggregation treats NaN values. NaN is a value, unlike an arrow null,
+// which aggregations always skip.
+type NaNPolicy int
+
+const (
+	// NaNPropagate makes the result NaN if any value is NaN, as IEEE 754 arithmetic does.
+	NaNPropagate NaNPolicy = iota
+	// NaNSkip leaves NaN values out of the aggregation as if they were null.
+	NaNSkip
+	// NaNError returns an error if any value is NaN.
+	NaNError
+)
+
+func (p NaNPolicy) String() string {
+	switch p {
+	case NaNPropagate:
+		return "propagate"
+	case NaNSkip:
+		return "skip"
+	case NaNError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,31 @@
+package utils
+
+// OverflowPolicy decides what an integer aggregation or arithmetic does when its result
+// does not fit in the result type.
+type OverflowPolicy int
+
+const (
+	// OverflowError returns an error.
+	OverflowError OverflowPolicy = iota
+	// OverflowWrap keeps the low bits of the result, as two's complement arithmetic in Go does.
+	OverflowWrap
+	// OverflowSaturate clamps the result to the minimum or maximum value of the result type.
+	OverflowSaturate
+	// OverflowPromoteToDecimal returns the exact result as a wider decimal type.
+	OverflowPromoteToDecimal
+)
+
+func (p OverflowPolicy) String() string {
+	switch p {
+	case OverflowError:
+		return "error"
+	case OverflowWrap:
+		return "wrap"
+	case OverflowSaturate:
+		return "saturate"
+	case OverflowPromoteToDecimal:
+		return "promote_to_decimal"
+	default:
+		return "unknown"
+	}
+}
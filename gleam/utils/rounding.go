@@ -0,0 +1,42 @@
+package utils
+
+// RoundingMode decides how a value is rounded to fewer fractional digits, e.g. when it is cast to a decimal type.
+type RoundingMode int
+
+const (
+	// RoundHalfEven rounds to the nearest value and ties to the even neighbour (banker's rounding).
+	RoundHalfEven RoundingMode = iota
+	// RoundHalfUp rounds to the nearest value and ties away from zero.
+	RoundHalfUp
+	// RoundHalfDown rounds to the nearest value and ties towards zero.
+	RoundHalfDown
+	// RoundDown rounds towards zero, i.e. truncates.
+	RoundDown
+	// RoundUp rounds away from zero.
+	RoundUp
+	// RoundFloor rounds towards negative infinity.
+	RoundFloor
+	// RoundCeiling rounds towards positive infinity.
+	RoundCeiling
+)
+
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundHalfEven:
+		return "half_even"
+	case RoundHalfUp:
+		return "half_up"
+	case RoundHalfDown:
+		return "half_down"
+	case RoundDown:
+		return "down"
+	case RoundUp:
+		return "up"
+	case RoundFloor:
+		return "floor"
+	case RoundCeiling:
+		return "ceiling"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,44 @@
+package utils
+
+// NullPlacement decides where a sort puts the null values, whatever the sort direction.
+type NullPlacement int
+
+const (
+	// NullsLast puts the null values after all the other values.
+	NullsLast NullPlacement = iota
+	// NullsFirst puts the null values before all the other values.
+	NullsFirst
+)
+
+func (p NullPlacement) String() string {
+	switch p {
+	case NullsLast:
+		return "nulls_last"
+	case NullsFirst:
+		return "nulls_first"
+	default:
+		return "unknown"
+	}
+}
+
+// NaNPlacement decides where a sort puts the NaN values of a float Series, whatever the sort direction.
+// NaN values are placed inside the null values, e.g. before them if both are last.
+type NaNPlacement int
+
+const (
+	// NaNLast puts the NaN values after the numbers.
+	NaNLast NaNPlacement = iota
+	// NaNFirst puts the NaN values before the numbers.
+	NaNFirst
+)
+
+func (p NaNPlacement) String() string {
+	switch p {
+	case NaNLast:
+		return "nan_last"
+	case NaNFirst:
+		return "nan_first"
+	default:
+		return "unknown"
+	}
+}
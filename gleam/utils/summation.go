@@ -0,0 +1,24 @@
+package utils
+
+// SumMode decides how float values are accumulated by Sum and Mean. Integer values are always summed exactly.
+type SumMode int
+
+const (
+	// SumFast accumulates naively with the assembly-optimized arrow kernels.
+	// The chunks of a concurrent sum follow the CPU count, so the last bits of the result can differ between machines.
+	SumFast SumMode = iota
+	// SumPrecise accumulates with Kahan-Babuska (Neumaier) compensation over fixed-size chunks merged in order,
+	// so the result is bit-identical across runs and machines.
+	SumPrecise
+)
+
+func (m SumMode) String() string {
+	switch m {
+	case SumFast:
+		return "fast"
+	case SumPrecise:
+		return "precise"
+	default:
+		return "unknown"
+	}
+}
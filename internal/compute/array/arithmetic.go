@@ -0,0 +1,571 @@
+package array
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/bitutil"
+	"github.com/apache/arrow-go/v18/arrow/compute"
+	"github.com/apache/arrow-go/v18/arrow/decimal128"
+	"github.com/apache/arrow-go/v18/arrow/decimal256"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/arrow/scalar"
+
+	"github.com/SHIMA0111/gleam/gleam/utils"
+	internalUtils "github.com/SHIMA0111/gleam/internal/utils"
+)
+
+// ArithmeticOp is an element-wise arithmetic operation.
+type ArithmeticOp int
+
+const (
+	OpAdd ArithmeticOp = iota
+	OpSub
+	OpMul
+	// OpDiv is the true division, whose result is a float.
+	OpDiv
+	// OpFloorDiv rounds the quotient towards negative infinity.
+	OpFloorDiv
+	// OpMod is the remainder of OpFloorDiv, which has the sign of the divisor.
+	OpMod
+	// OpPow raises to a power, whose result is a float.
+	OpPow
+	// OpNeg negates its only operand.
+	OpNeg
+)
+
+func (op ArithmeticOp) String() string {
+	switch op {
+	case OpAdd:
+		return "+"
+	case OpSub:
+		return "-"
+	case OpMul:
+		return "*"
+	case OpDiv:
+		return "/"
+	case OpFloorDiv:
+		return "//"
+	case OpMod:
+		return "%"
+	case OpPow:
+		return "**"
+	case OpNeg:
+		return "neg"
+	default:
+		return "unknown"
+	}
+}
+
+// IsNumeric reports whether the type is an integer, Float32 or Float64 type, which arithmetic supports.
+func IsNumeric(dtype arrow.DataType) bool {
+	id := dtype.ID()
+	return arrow.IsInteger(id) || id == arrow.FLOAT32 || id == arrow.FLOAT64
+}
+
+// PromoteNumeric returns the type two numeric operands are converted to before an arithmetic operation:
+// the wider type of the same kind, a signed type wide enough for both a signed and an unsigned type, and a float
+// type if either is a float. Float32 is kept only with Float32 or integers of up to 16 bits, which it represents
+// exactly. Uint64 with a signed type promotes to Float64, as no integer type holds both.
+func PromoteNumeric(left, right arrow.DataType) (arrow.DataType, error) {
+	if !IsNumeric(left) || !IsNumeric(right) {
+		return nil, fmt.Errorf("arithmetic is not supported between %s and %s", left, right)
+	}
+
+	if arrow.TypeEqual(left, right) {
+		return left, nil
+	}
+
+	leftWidth, rightWidth := bitWidth(left), bitWidth(right)
+	leftFloat, rightFloat := arrow.IsFloating(left.ID()), arrow.IsFloating(right.ID())
+	switch {
+	case leftFloat || rightFloat:
+		if max(leftWidth, rightWidth) <= 32 && (leftFloat && rightFloat || min(leftWidth, rightWidth) <= 16) {
+			return arrow.PrimitiveTypes.Float32, nil
+		}
+		return arrow.PrimitiveTypes.Float64, nil
+	case arrow.IsSignedInteger(left.ID()) == arrow.IsSignedInteger(right.ID()):
+		if leftWidth >= rightWidth {
+			return left, nil
+		}
+		return right, nil
+	}
+
+	signedWidth, unsignedWidth := leftWidth, rightWidth
+	if arrow.IsUnsignedInteger(left.ID()) {
+		signedWidth, unsignedWidth = rightWidth, leftWidth
+	}
+	switch width := max(signedWidth, 2*unsignedWidth); width {
+	case 16:
+		return arrow.PrimitiveTypes.Int16, nil
+	case 32:
+		return arrow.PrimitiveTypes.Int32, nil
+	case 64:
+		return arrow.PrimitiveTypes.Int64, nil
+	default:
+		return arrow.PrimitiveTypes.Float64, nil
+	}
+}
+
+// Arithmetic applies the binary operation to the elements of the arrays at the same positions.
+// The operands are promoted by PromoteNumeric; see ArithmeticScalar for the semantics of the operations.
+// Returns an error if the arrays differ in length or are not numeric.
+func Arithmetic(
+	ctx context.Context,
+	op ArithmeticOp,
+	left, right arrow.Array,
+	policy utils.OverflowPolicy,
+	mem memory.Allocator,
+) (arrow.Array, error) {
+	if left.Len() != right.Len() {
+		return nil, fmt.Errorf("array length is not equal to the other array length: %d != %d", left.Len(), right.Len())
+	}
+
+	return arithmetic(ctx, op, left, right, false, policy, mem)
+}
+
+// ArithmeticScalar applies the binary operation to every element of the array and the value.
+// An integer value takes the integer type of the array if it fits, and a numeric value takes the float type of
+// a float array, so that a literal does not widen the array; otherwise the operands are promoted by PromoteNumeric.
+// Nulls propagate. Integer results that overflow are handled by the overflow policy; OpDiv and OpPow compute in
+// floats, and float operations follow IEEE 754, e.g. a division by zero gives an infinity or NaN.
+// An integer OpFloorDiv or OpMod by zero gives null.
+func ArithmeticScalar(
+	ctx context.Context,
+	op ArithmeticOp,
+	arr arrow.Array,
+	value scalar.Scalar,
+	policy utils.OverflowPolicy,
+	mem memory.Allocator,
+) (arrow.Array, error) {
+	if !IsNumeric(value.DataType()) {
+		return nil, fmt.Errorf("arithmetic is not supported between %s and %s", arr.DataType(), value.DataType())
+	}
+
+	literal, err := value.CastTo(literalType(value, arr.DataType()))
+	if err != nil {
+		return nil, err
+	}
+
+	valueArr, err := scalar.MakeArrayFromScalar(literal, 1, mem)
+	if err != nil {
+		return nil, err
+	}
+	defer valueArr.Release()
+
+	return arithmetic(ctx, op, arr, valueArr, true, policy, mem)
+}
+
+// Negate negates every element of a signed integer or float array. Nulls propagate and an integer
+// overflow, i.e. negating the minimum value, is handled by the overflow policy.
+func Negate(ctx context.Context, arr arrow.Array, policy utils.OverflowPolicy, mem memory.Allocator) (arrow.Array, error) {
+	if !IsNumeric(arr.DataType()) || arrow.IsUnsignedInteger(arr.DataType().ID()) {
+		return nil, fmt.Errorf("cannot negate %s array", arr.DataType())
+	}
+
+	return arithmetic(ctx, OpNeg, arr, arr, false, policy, mem)
+}
+
+// literalType returns the type a numeric literal takes against an array of type dtype.
+func literalType(value scalar.Scalar, dtype arrow.DataType) arrow.DataType {
+	id := value.DataType().ID()
+	switch {
+	case arrow.IsFloating(dtype.ID()) && IsNumeric(dtype):
+		return dtype
+	case arrow.IsInteger(dtype.ID()) && arrow.IsInteger(id) && value.IsValid():
+		// The literal fits if it survives the round trip through the type of the array
+		casted, err := value.CastTo(dtype)
+		if err != nil {
+			return value.DataType()
+		}
+		back, err := casted.CastTo(value.DataType())
+		if err == nil && scalar.Equals(back, value) {
+			return dtype
+		}
+	}
+
+	return value.DataType()
+}
+
+// arithmetic promotes the operands and applies the operation, broadcasting the first element of right
+// if broadcast is true.
+func arithmetic(
+	ctx context.Context,
+	op ArithmeticOp,
+	left, right arrow.Array,
+	broadcast bool,
+	policy utils.OverflowPolicy,
+	mem memory.Allocator,
+) (arrow.Array, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	dtype, err := PromoteNumeric(left.DataType(), right.DataType())
+	if err != nil {
+		return nil, err
+	}
+	if (op == OpDiv || op == OpPow) && !arrow.IsFloating(dtype.ID()) {
+		dtype = arrow.PrimitiveTypes.Float64
+	}
+
+	left, err = castNumeric(ctx, left, dtype)
+	if err != nil {
+		return nil, err
+	}
+	defer left.Release()
+
+	right, err = castNumeric(ctx, right, dtype)
+	if err != nil {
+		return nil, err
+	}
+	defer right.Release()
+
+	switch dtype.ID() {
+	case arrow.INT8:
+		return arithmeticValues[int8](op, left, right, broadcast, policy, mem)
+	case arrow.INT16:
+		return arithmeticValues[int16](op, left, right, broadcast, policy, mem)
+	case arrow.INT32:
+		return arithmeticValues[int32](op, left, right, broadcast, policy, mem)
+	case arrow.INT64:
+		return arithmeticValues[int64](op, left, right, broadcast, policy, mem)
+	case arrow.UINT8:
+		return arithmeticValues[uint8](op, left, right, broadcast, policy, mem)
+	case arrow.UINT16:
+		return arithmeticValues[uint16](op, left, right, broadcast, policy, mem)
+	case arrow.UINT32:
+		return arithmeticValues[uint32](op, left, right, broadcast, policy, mem)
+	case arrow.UINT64:
+		return arithmeticValues[uint64](op, left, right, broadcast, policy, mem)
+	case arrow.FLOAT32:
+		return arithmeticValues[float32](op, left, right, broadcast, policy, mem)
+	default:
+		return arithmeticValues[float64](op, left, right, broadcast, policy, mem)
+	}
+}
+
+// castNumeric converts a numeric array to the promoted type dtype, returning a new reference.
+// The conversion is unchecked, as promotion only widens, except that large integers may round to a float.
+func castNumeric(ctx context.Context, arr arrow.Array, dtype arrow.DataType) (arrow.Array, error) {
+	if arrow.TypeEqual(arr.DataType(), dtype) {
+		arr.Retain()
+		return arr, nil
+	}
+
+	return compute.CastArray(ctx, arr, compute.UnsafeCastOptions(dtype))
+}
+
+// arithmeticValues applies the operation to operands of the type of T, which is also the result type.
+func arithmeticValues[T internalUtils.Numeric](
+	op ArithmeticOp,
+	left, right arrow.Array,
+	broadcast bool,
+	policy utils.OverflowPolicy,
+	mem memory.Allocator,
+) (arrow.Array, error) {
+	length := left.Len()
+	leftValues := numericValues[T](left)
+	rightValues := numericValues[T](right)
+	rightAt := func(i int) int { return i }
+	if broadcast {
+		rightAt = func(int) int { return 0 }
+	}
+
+	values := memory.NewResizableBuffer(mem)
+	defer values.Release()
+	values.Resize(length * bitWidth(left.DataType()) / 8)
+	out := arrow.GetData[T](values.Bytes())
+
+	validity := memory.NewResizableBuffer(mem)
+	defer validity.Release()
+	validity.Resize(int(bitutil.BytesForBits(int64(length))))
+	bitmap := validity.Bytes()
+
+	kind := kindOf[T]()
+	overflowed := false
+	nulls := 0
+	for i := 0; i < length; i++ {
+		j := rightAt(i)
+		if left.IsNull(i) || right.IsNull(j) {
+			bitutil.ClearBit(bitmap, i)
+			nulls++
+			continue
+		}
+
+		r, valid, overflow := applyArithmetic(op, kind, leftValues[i], rightValues[j])
+		if !valid {
+			bitutil.ClearBit(bitmap, i)
+			nulls++
+			continue
+		}
+		if overflow {
+			switch policy {
+			case utils.OverflowError:
+				return nil, fmt.Errorf("integer overflow in %s: %v %s %v", left.DataType(), leftValues[i], op, rightValues[j])
+			case utils.OverflowSaturate:
+				r = saturated[T](op, leftValues[i], rightValues[j])
+			case utils.OverflowPromoteToDecimal:
+				overflowed = true
+			}
+		}
+		bitutil.SetBit(bitmap, i)
+		out[i] = r
+	}
+
+	if overflowed {
+		return exactArithmetic(op, kind, leftValues, rightValues, left, right, rightAt, mem)
+	}
+
+	var nullBitmap *memory.Buffer
+	if nulls > 0 {
+		nullBitmap = validity
+	}
+	data := array.NewData(left.DataType(), length, []*memory.Buffer{nullBitmap, values}, nil, nulls, 0)
+	defer data.Release()
+
+	return array.MakeFromData(data), nil
+}
+
+// numericKind tells how the values of a numeric type overflow.
+type numericKind int
+
+const (
+	signedKind numericKind = iota
+	unsignedKind
+	floatKind
+)
+
+func kindOf[T internalUtils.Numeric]() numericKind {
+	var zero T
+	switch any(zero).(type) {
+	case float32, float64:
+		return floatKind
+	case uint8, uint16, uint32, uint64:
+		return unsignedKind
+	default:
+		return signedKind
+	}
+}
+
+// numericValues returns the values of a numeric array of the type of T, starting at the array offset.
+// An empty array may have no values buffer, and has no values.
+func numericValues[T internalUtils.Numeric](arr arrow.Array) []T {
+	buffer := arr.Data().Buffers()[1]
+	if arr.Len() == 0 || buffer == nil {
+		return nil
+	}
+
+	values := arrow.GetData[T](buffer.Bytes())
+	return values[arr.Data().Offset() : arr.Data().Offset()+arr.Len()]
+}
+
+// applyArithmetic applies the operation to a and b. valid is false if the result is null,
+// and overflow is true if an integer result does not fit in T, in which case r is the wrapped result.
+func applyArithmetic[T internalUtils.Numeric](op ArithmeticOp, kind numericKind, a, b T) (r T, valid bool, overflow bool) {
+	if kind == floatKind {
+		x, y := float64(a), float64(b)
+		switch op {
+		case OpAdd:
+			return a + b, true, false
+		case OpSub:
+			return a - b, true, false
+		case OpMul:
+			return a * b, true, false
+		case OpDiv:
+			return a / b, true, false
+		case OpFloorDiv:
+			return T(math.Floor(x / y)), true, false
+		case OpMod:
+			m := math.Mod(x, y)
+			if m != 0 && (m < 0) != (y < 0) {
+				m += y
+			}
+			return T(m), true, false
+		case OpPow:
+			return T(math.Pow(x, y)), true, false
+		default:
+			return -a, true, false
+		}
+	}
+
+	switch op {
+	case OpAdd:
+		r = a + b
+		return r, true, (b > 0 && r < a) || (b < 0 && r > a)
+	case OpSub:
+		r = a - b
+		return r, true, (b > 0 && r > a) || (b < 0 && r < a)
+	case OpMul:
+		r = a * b
+		// Wrapping hides the overflow of -1 * min in the division check, as min / -1 wraps to min as well
+		return r, true, a != 0 && (r/a != b || (kind == signedKind && a+1 == 0 && r == b && b != 0))
+	case OpFloorDiv:
+		if b == 0 {
+			return 0, false, false
+		}
+		r = a / b
+		if a-r*b != 0 && (a < 0) != (b < 0) {
+			r--
+		}
+		// min / -1 is the only quotient that does not fit, and it wraps to min
+		return r, true, kind == signedKind && b+1 == 0 && a < 0 && r == a
+	case OpMod:
+		if b == 0 {
+			return 0, false, false
+		}
+		// T may be a float type, for which % is not defined, so the remainder is derived from the quotient
+		r = a - (a/b)*b
+		if r != 0 && (r < 0) != (b < 0) {
+			r += b
+		}
+		return r, true, false
+	case OpNeg:
+		r = -a
+		return r, true, a < 0 && r == a
+	default:
+		return 0, false, false
+	}
+}
+
+// saturated returns the limit of T in the direction of the overflowing result of a op b.
+func saturated[T internalUtils.Numeric](op ArithmeticOp, a, b T) T {
+	minValue, maxValue := limits[T]()
+	negative := false
+	switch op {
+	case OpAdd:
+		negative = b < 0
+	case OpSub:
+		negative = b > 0
+	case OpMul, OpFloorDiv:
+		negative = (a < 0) != (b < 0)
+	case OpNeg:
+		negative = a > 0
+	}
+
+	if negative {
+		return minValue
+	}
+	return maxValue
+}
+
+// limits returns the minimum and maximum values of the integer type T.
+func limits[T internalUtils.Numeric]() (T, T) {
+	var zero T
+	var minValue, maxValue any
+	switch any(zero).(type) {
+	case int8:
+		minValue, maxValue = int8(math.MinInt8), int8(math.MaxInt8)
+	case int16:
+		minValue, maxValue = int16(math.MinInt16), int16(math.MaxInt16)
+	case int32:
+		minValue, maxValue = int32(math.MinInt32), int32(math.MaxInt32)
+	case int64:
+		minValue, maxValue = int64(math.MinInt64), int64(math.MaxInt64)
+	case uint8:
+		minValue, maxValue = uint8(0), uint8(math.MaxUint8)
+	case uint16:
+		minValue, maxValue = uint16(0), uint16(math.MaxUint16)
+	case uint32:
+		minValue, maxValue = uint32(0), uint32(math.MaxUint32)
+	case uint64:
+		minValue, maxValue = uint64(0), uint64(math.MaxUint64)
+	default:
+		return zero, zero
+	}
+
+	return minValue.(T), maxValue.(T)
+}
+
+// exactArithmetic applies an integer operation exactly, for utils.OverflowPromoteToDecimal. The result is a
+// Decimal128 of precision 38 and scale 0, or a Decimal256 of precision 76 if a result has more than 38 digits.
+func exactArithmetic[T internalUtils.Numeric](
+	op ArithmeticOp,
+	kind numericKind,
+	leftValues, rightValues []T,
+	left, right arrow.Array,
+	rightAt func(int) int,
+	mem memory.Allocator,
+) (arrow.Array, error) {
+	results := make([]*big.Int, left.Len())
+	wide := false
+	for i := range results {
+		j := rightAt(i)
+		if left.IsNull(i) || right.IsNull(j) {
+			continue
+		}
+
+		a, b := bigOf(leftValues[i], kind), bigOf(rightValues[j], kind)
+		r := new(big.Int)
+		switch op {
+		case OpAdd:
+			r.Add(a, b)
+		case OpSub:
+			r.Sub(a, b)
+		case OpMul:
+			r.Mul(a, b)
+		case OpFloorDiv:
+			if b.Sign() == 0 {
+				continue
+			}
+			m := new(big.Int)
+			r.QuoRem(a, b, m)
+			if m.Sign() != 0 && (m.Sign() < 0) != (b.Sign() < 0) {
+				r.Sub(r, big.NewInt(1))
+			}
+		case OpMod:
+			if b.Sign() == 0 {
+				continue
+			}
+			r.Rem(a, b)
+			if r.Sign() != 0 && (r.Sign() < 0) != (b.Sign() < 0) {
+				r.Add(r, b)
+			}
+		case OpNeg:
+			r.Neg(a)
+		}
+		results[i] = r
+		wide = wide || !internalUtils.FitsInPrecision(r, decimal128.MaxPrecision)
+	}
+
+	if wide {
+		builder := array.NewDecimal256Builder(mem, &arrow.Decimal256Type{Precision: decimal256.MaxPrecision})
+		defer builder.Release()
+		for _, r := range results {
+			if r == nil {
+				builder.AppendNull()
+				continue
+			}
+			builder.Append(decimal256.FromBigInt(r))
+		}
+		return builder.NewArray(), nil
+	}
+
+	builder := array.NewDecimal128Builder(mem, &arrow.Decimal128Type{Precision: decimal128.MaxPrecision})
+	defer builder.Release()
+	for _, r := range results {
+		if r == nil {
+			builder.AppendNull()
+			continue
+		}
+		builder.Append(decimal128.FromBigInt(r))
+	}
+
+	return builder.NewArray(), nil
+}
+
+// bigOf returns the integer value v of the kind as a big.Int.
+func bigOf[T internalUtils.Numeric](v T, kind numericKind) *big.Int {
+	if kind == unsignedKind {
+		return new(big.Int).SetUint64(uint64(v))
+	}
+	return big.NewInt(int64(v))
+}
+
+func bitWidth(dtype arrow.DataType) int {
+	return dtype.(arrow.FixedWidthDataType).BitWidth()
+}
@@ -0,0 +1,157 @@
+package array
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+)
+
+// binaryLike is a String, LargeString, Binary or LargeBinary array.
+type binaryLike interface {
+	arrow.Array
+	ValueBytes() []byte
+}
+
+// CastFixedSizeBinary converts a string or binary array to the FixedSizeBinary type dtype.
+// Returns an error if a value does not have exactly the byte width of dtype. Nulls stay null.
+func CastFixedSizeBinary(
+	ctx context.Context,
+	arr arrow.Array,
+	dtype *arrow.FixedSizeBinaryType,
+	mem memory.Allocator,
+) (arrow.Array, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var valueAt func(i int) []byte
+	switch a := arr.(type) {
+	case *array.String:
+		valueAt = func(i int) []byte { return []byte(a.Value(i)) }
+	case *array.LargeString:
+		valueAt = func(i int) []byte { return []byte(a.Value(i)) }
+	case *array.Binary:
+		valueAt = a.Value
+	case *array.LargeBinary:
+		valueAt = a.Value
+	case *array.FixedSizeBinary:
+		valueAt = a.Value
+	default:
+		return nil, fmt.Errorf("cannot cast %s to %s", arr.DataType(), dtype)
+	}
+
+	builder := array.NewFixedSizeBinaryBuilder(mem, dtype)
+	defer builder.Release()
+	builder.Reserve(arr.Len())
+
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			builder.AppendNull()
+			continue
+		}
+
+		value := valueAt(i)
+		if len(value) != dtype.ByteWidth {
+			return nil, fmt.Errorf("value of %d bytes does not fit in %s", len(value), dtype)
+		}
+		builder.Append(value)
+	}
+
+	return builder.NewArray(), nil
+}
+
+// Concat appends the arrays one after another into a new array. The arrays must have the same type, except that
+// String and LargeString, or Binary and LargeBinary, arrays can be mixed. Those are concatenated as the large type
+// if one of them is large or if their data exceeds the 2 GiB that 32-bit offsets can address.
+func Concat(ctx context.Context, arrs []arrow.Array, mem memory.Allocator) (arrow.Array, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(arrs) == 0 {
+		return nil, fmt.Errorf("cannot concatenate no arrays")
+	}
+
+	if large, ok := largeOffsetType(arrs); ok {
+		promoted := make([]arrow.Array, len(arrs))
+		for i, arr := range arrs {
+			if arrow.TypeEqual(arr.DataType(), large) {
+				arr.Retain()
+				promoted[i] = arr
+				continue
+			}
+
+			casted, err := Cast(ctx, arr, large, CastOptions{}, mem)
+			if err != nil {
+				for _, done := range promoted[:i] {
+					done.Release()
+				}
+				return nil, err
+			}
+			promoted[i] = casted
+		}
+		defer func() {
+			for _, arr := range promoted {
+				arr.Release()
+			}
+		}()
+		arrs = promoted
+	}
+
+	for _, arr := range arrs[1:] {
+		if !arrow.TypeEqual(arr.DataType(), arrs[0].DataType()) {
+			return nil, fmt.Errorf("cannot concatenate %s with %s", arrs[0].DataType(), arr.DataType())
+		}
+	}
+
+	return array.Concatenate(arrs, mem)
+}
+
+// largeOffsetType returns the large type that the string or binary arrays must be concatenated as, if some of them
+// have to be promoted to it.
+func largeOffsetType(arrs []arrow.Array) (arrow.DataType, bool) {
+	var large arrow.DataType
+	switch arrs[0].DataType().ID() {
+	case arrow.STRING, arrow.LARGE_STRING:
+		large = arrow.BinaryTypes.LargeString
+	case arrow.BINARY, arrow.LARGE_BINARY:
+		large = arrow.BinaryTypes.LargeBinary
+	default:
+		return nil, false
+	}
+
+	var dataSize int64
+	hasLarge, hasSmall := false, false
+	for _, arr := range arrs {
+		values, ok := arr.(binaryLike)
+		if !ok || (arr.DataType().ID() != large.ID() && largeOf(arr.DataType()) != large.ID()) {
+			// Mismatching types are reported by Concat
+			return nil, false
+		}
+		hasLarge = hasLarge || arr.DataType().ID() == large.ID()
+		hasSmall = hasSmall || arr.DataType().ID() != large.ID()
+		dataSize += int64(len(values.ValueBytes()))
+	}
+
+	if hasSmall && (hasLarge || dataSize > math.MaxInt32) {
+		return large, true
+	}
+
+	return nil, false
+}
+
+// largeOf returns the type ID of the large variant of a String or Binary type.
+func largeOf(dtype arrow.DataType) arrow.Type {
+	switch dtype.ID() {
+	case arrow.STRING:
+		return arrow.LARGE_STRING
+	case arrow.BINARY:
+		return arrow.LARGE_BINARY
+	default:
+		return dtype.ID()
+	}
+}
@@ -0,0 +1,89 @@
+package array
+
+import (
+	"context"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/compute"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+
+	"github.com/SHIMA0111/gleam/gleam/utils"
+)
+
+// CastOptions configures the conversions that Cast does itself rather than with the arrow cast kernels.
+type CastOptions struct {
+	// Layout is the Go time layout of strings parsed into or formatted from temporal types.
+	Layout string
+	// Rounding rounds values cast to a decimal type with fewer fractional digits.
+	Rounding utils.RoundingMode
+}
+
+// Cast converts the array to the target type.
+// Conversions that the arrow cast kernels do not cover, or not exactly, are done here: strings are parsed into and
+// temporal values formatted as strings with the Go time layout (see ParseTemporal and FormatTemporal), values cast
+// to a decimal type are rounded with the rounding mode (see CastDecimal), decimals are formatted as strings and
+// values are dictionary-encoded after a cast to the value type of the dictionary (see EncodeDictionary)
+// and decoded before a cast from it. Strings and binaries cast to FixedSizeBinary must have its byte width.
+// Other conversions use the arrow cast kernels.
+func Cast(ctx context.Context, arr arrow.Array, target arrow.DataType, options CastOptions, mem memory.Allocator) (arrow.Array, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case isString(arr.DataType()) && IsTemporal(target):
+		return ParseTemporal(ctx, arr, target, options.Layout, mem)
+	case IsTemporal(arr.DataType()) && target.ID() == arrow.STRING:
+		return FormatTemporal(ctx, arr, options.Layout, mem)
+	case IsDecimal(target):
+		return CastDecimal(ctx, arr, target, options.Rounding, mem)
+	case IsDecimal(arr.DataType()) && target.ID() == arrow.STRING:
+		return FormatDecimal(ctx, arr, mem)
+	case IsDictionary(target):
+		return castToDictionary(ctx, arr, target.(*arrow.DictionaryType), options, mem)
+	case IsDictionary(arr.DataType()):
+		return castFromDictionary(ctx, arr, target, options, mem)
+	case target.ID() == arrow.FIXED_SIZE_BINARY:
+		return CastFixedSizeBinary(ctx, arr, target.(*arrow.FixedSizeBinaryType), mem)
+	default:
+		return compute.CastToType(ctx, arr, target)
+	}
+}
+
+// castToDictionary casts the array to the value type of the dictionary type and encodes it.
+func castToDictionary(
+	ctx context.Context,
+	arr arrow.Array,
+	target *arrow.DictionaryType,
+	options CastOptions,
+	mem memory.Allocator,
+) (arrow.Array, error) {
+	values, err := Cast(ctx, arr, target.ValueType, options, mem)
+	if err != nil {
+		return nil, err
+	}
+	defer values.Release()
+
+	return EncodeDictionary(ctx, values, target, mem)
+}
+
+// castFromDictionary decodes the dictionary array and casts the decoded values to the target type.
+func castFromDictionary(
+	ctx context.Context,
+	arr arrow.Array,
+	target arrow.DataType,
+	options CastOptions,
+	mem memory.Allocator,
+) (arrow.Array, error) {
+	values, err := compute.CastToType(ctx, arr, arr.DataType().(*arrow.DictionaryType).ValueType)
+	if err != nil {
+		return nil, err
+	}
+	defer values.Release()
+
+	return Cast(ctx, values, target, options, mem)
+}
+
+func isString(dtype arrow.DataType) bool {
+	return dtype.ID() == arrow.STRING || dtype.ID() == arrow.LARGE_STRING
+}
@@ -1,17 +1,29 @@
 package array
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"math"
 
 	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
 	"github.com/apache/arrow-go/v18/arrow/compute"
+	"github.com/apache/arrow-go/v18/arrow/memory"
 	"github.com/apache/arrow-go/v18/arrow/scalar"
 
 	"github.com/SHIMA0111/gleam/gleam/utils"
 )
 
 func Comparison(ctx context.Context, arr arrow.Array, cond utils.CompareOperand, value scalar.Scalar) (arrow.Array, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if dictArr, ok := arr.(*array.Dictionary); ok {
+		return DictionaryComparison(ctx, dictArr, cond, value)
+	}
+
 	dataDatum := compute.NewDatum(arr)
 	defer dataDatum.Release()
 	scalarDatum := compute.NewDatum(value)
@@ -30,3 +42,242 @@ func Comparison(ctx context.Context, arr arrow.Array, cond utils.CompareOperand,
 
 	return conditionArray.MakeArray(), nil
 }
+
+// ComparisonArrays compares the elements of two arrays at the same positions with the condition; a null element
+// on either side gives null. Dictionary arrays compare by their values, and numeric arrays of different types are
+// promoted by PromoteNumeric first, e.g. Int32 and Float64 compare as Float64. A 64-bit integer array would lose
+// precision in Float64, so it is compared exactly with a Uint64 array of the other sign or with a float array.
+// Returns an error if the arrays differ in length or their types cannot be compared.
+func ComparisonArrays(
+	ctx context.Context,
+	left, right arrow.Array,
+	cond utils.CompareOperand,
+	mem memory.Allocator,
+) (arrow.Array, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if left.Len() != right.Len() {
+		return nil, fmt.Errorf("array length is not equal to the other array length: %d != %d", left.Len(), right.Len())
+	}
+
+	left, err := decodeDictionary(ctx, left)
+	if err != nil {
+		return nil, err
+	}
+	defer left.Release()
+
+	right, err = decodeDictionary(ctx, right)
+	if err != nil {
+		return nil, err
+	}
+	defer right.Release()
+
+	if IsNumeric(left.DataType()) && IsNumeric(right.DataType()) && !arrow.TypeEqual(left.DataType(), right.DataType()) {
+		dtype, err := PromoteNumeric(left.DataType(), right.DataType())
+		if err != nil {
+			return nil, err
+		}
+		if dtype.ID() == arrow.FLOAT64 && (bitWidth(left.DataType()) == 64 && arrow.IsInteger(left.DataType().ID()) ||
+			bitWidth(right.DataType()) == 64 && arrow.IsInteger(right.DataType().ID())) {
+			return exactComparison(ctx, left, right, cond, mem)
+		}
+
+		left, err = castNumeric(ctx, left, dtype)
+		if err != nil {
+			return nil, err
+		}
+		defer left.Release()
+
+		right, err = castNumeric(ctx, right, dtype)
+		if err != nil {
+			return nil, err
+		}
+		defer right.Release()
+	}
+
+	leftDatum := compute.NewDatum(left)
+	defer leftDatum.Release()
+	rightDatum := compute.NewDatum(right)
+	defer rightDatum.Release()
+
+	conditionDatum, err := compute.CallFunction(ctx, cond.String(), nil, leftDatum, rightDatum)
+	if err != nil {
+		return nil, fmt.Errorf("cannot compare %s array with %s array: %w", left.DataType(), right.DataType(), err)
+	}
+	defer conditionDatum.Release()
+
+	conditionArray, ok := conditionDatum.(*compute.ArrayDatum)
+	if !ok {
+		return nil, fmt.Errorf("comparison did not return an array datum")
+	}
+
+	return conditionArray.MakeArray(), nil
+}
+
+// exactComparison compares two numeric arrays of different types without rounding, for the pairs whose common
+// type Float64 cannot represent every value. Each side is widened to Int64, Uint64 or Float64, which is exact.
+func exactComparison(
+	ctx context.Context,
+	left, right arrow.Array,
+	cond utils.CompareOperand,
+	mem memory.Allocator,
+) (arrow.Array, error) {
+	left, leftAt, err := exactValues(ctx, left)
+	if err != nil {
+		return nil, err
+	}
+	defer left.Release()
+
+	right, rightAt, err := exactValues(ctx, right)
+	if err != nil {
+		return nil, err
+	}
+	defer right.Release()
+
+	builder := array.NewBooleanBuilder(mem)
+	defer builder.Release()
+	builder.Reserve(left.Len())
+
+	for i := 0; i < left.Len(); i++ {
+		if left.IsNull(i) || right.IsNull(i) {
+			builder.AppendNull()
+			continue
+		}
+
+		order, ordered := compareExact(leftAt(i), rightAt(i))
+		switch cond {
+		case utils.Equal:
+			builder.Append(ordered && order == 0)
+		case utils.NotEqual:
+			builder.Append(!ordered || order != 0)
+		case utils.Greater:
+			builder.Append(ordered && order > 0)
+		case utils.GreaterEqual:
+			builder.Append(ordered && order >= 0)
+		case utils.Less:
+			builder.Append(ordered && order < 0)
+		case utils.LessEqual:
+			builder.Append(ordered && order <= 0)
+		default:
+			return nil, fmt.Errorf("unsupported comparison %s", cond)
+		}
+	}
+
+	return builder.NewArray(), nil
+}
+
+// exactNumber is a numeric value as an Int64, a Uint64 or a Float64, whichever holds its type exactly.
+type exactNumber struct {
+	kind numericKind
+	i    int64
+	u    uint64
+	f    float64
+}
+
+// exactValues widens a numeric array to Int64, Uint64 or Float64, whichever holds its type exactly, and returns
+// the widened array, which the caller releases, with a function reading its element i as an exactNumber.
+func exactValues(ctx context.Context, arr arrow.Array) (arrow.Array, func(i int) exactNumber, error) {
+	var kind numericKind
+	var dtype arrow.DataType
+	switch {
+	case arrow.IsSignedInteger(arr.DataType().ID()):
+		kind, dtype = signedKind, arrow.PrimitiveTypes.Int64
+	case arrow.IsUnsignedInteger(arr.DataType().ID()):
+		kind, dtype = unsignedKind, arrow.PrimitiveTypes.Uint64
+	default:
+		kind, dtype = floatKind, arrow.PrimitiveTypes.Float64
+	}
+
+	widened, err := castNumeric(ctx, arr, dtype)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch kind {
+	case signedKind:
+		values := numericValues[int64](widened)
+		return widened, func(i int) exactNumber { return exactNumber{kind: kind, i: values[i]} }, nil
+	case unsignedKind:
+		values := numericValues[uint64](widened)
+		return widened, func(i int) exactNumber { return exactNumber{kind: kind, u: values[i]} }, nil
+	default:
+		values := numericValues[float64](widened)
+		return widened, func(i int) exactNumber { return exactNumber{kind: kind, f: values[i]} }, nil
+	}
+}
+
+// compareExact returns the order of a and b, or ordered false if either is NaN.
+func compareExact(a, b exactNumber) (order int, ordered bool) {
+	switch {
+	case a.kind == b.kind:
+		switch a.kind {
+		case signedKind:
+			return cmp.Compare(a.i, b.i), true
+		case unsignedKind:
+			return cmp.Compare(a.u, b.u), true
+		}
+		if math.IsNaN(a.f) || math.IsNaN(b.f) {
+			return 0, false
+		}
+		return cmp.Compare(a.f, b.f), true
+	case b.kind == floatKind || b.kind == unsignedKind && a.kind == signedKind:
+		order, ordered = compareExact(b, a)
+		return -order, ordered
+	case a.kind == floatKind:
+		return compareFloatInteger(a.f, b)
+	default:
+		// a is unsigned and b is signed: a negative b is below every unsigned value
+		if b.i < 0 {
+			return 1, true
+		}
+		return cmp.Compare(a.u, uint64(b.i)), true
+	}
+}
+
+// compareFloatInteger compares the float f with the Int64 or Uint64 value b by the integral part of f,
+// and by its fractional part when the integral parts are equal.
+func compareFloatInteger(f float64, b exactNumber) (int, bool) {
+	if math.IsNaN(f) {
+		return 0, false
+	}
+
+	lower, upper := -math.Ldexp(1, 63), math.Ldexp(1, 63)
+	if b.kind == unsignedKind {
+		lower, upper = 0, math.Ldexp(1, 64)
+	}
+	// The bounds are powers of two, so they are exact, and f outside them is beyond every value of the type
+	switch {
+	case f < lower:
+		return -1, true
+	case f >= upper:
+		return 1, true
+	}
+
+	whole, fraction := math.Modf(f)
+	var order int
+	if b.kind == unsignedKind {
+		order = cmp.Compare(uint64(whole), b.u)
+	} else {
+		order = cmp.Compare(int64(whole), b.i)
+	}
+	if order != 0 {
+		return order, true
+	}
+
+	// Modf gives the fraction the sign of f
+	return cmp.Compare(fraction, 0), true
+}
+
+// decodeDictionary returns the values of the elements of a dictionary array, or any other array as is,
+// as a new reference.
+func decodeDictionary(ctx context.Context, arr arrow.Array) (arrow.Array, error) {
+	dictArr, ok := arr.(*array.Dictionary)
+	if !ok {
+		arr.Retain()
+		return arr, nil
+	}
+
+	return compute.TakeArray(ctx, dictArr.Dictionary(), dictArr.Indices())
+}
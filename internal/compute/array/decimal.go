@@ -0,0 +1,220 @@
+package array
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/decimal128"
+	"github.com/apache/arrow-go/v18/arrow/decimal256"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/arrow/scalar"
+
+	"github.com/SHIMA0111/gleam/gleam/utils"
+	internalUtils "github.com/SHIMA0111/gleam/internal/utils"
+)
+
+// decimalNum is the value type of a Decimal128 or Decimal256 array.
+type decimalNum[T any] interface {
+	decimal128.Num | decimal256.Num
+	Greater(other T) bool
+	Less(other T) bool
+}
+
+// IsDecimal reports whether the type is Decimal128 or Decimal256.
+func IsDecimal(dtype arrow.DataType) bool {
+	return dtype.ID() == arrow.DECIMAL128 || dtype.ID() == arrow.DECIMAL256
+}
+
+// CastDecimal converts an integer, float, string or decimal array to the Decimal128 or Decimal256 type dtype.
+// Values with more fractional digits than the scale of dtype are rounded with the rounding mode.
+// Returns an error if a value is not a number or does not fit in the precision of dtype. Nulls stay null.
+func CastDecimal(
+	ctx context.Context,
+	arr arrow.Array,
+	dtype arrow.DataType,
+	mode utils.RoundingMode,
+	mem memory.Allocator,
+) (arrow.Array, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	ratAt, err := ratReader(arr)
+	if err != nil {
+		return nil, err
+	}
+
+	decimalType, ok := dtype.(arrow.DecimalType)
+	if !ok || !IsDecimal(dtype) {
+		return nil, fmt.Errorf("cannot cast %s to %s", arr.DataType(), dtype)
+	}
+	precision, scale := decimalType.GetPrecision(), decimalType.GetScale()
+
+	builder := array.NewBuilder(mem, dtype)
+	defer builder.Release()
+	builder.Reserve(arr.Len())
+
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			builder.AppendNull()
+			continue
+		}
+
+		r, err := ratAt(i)
+		if err != nil {
+			return nil, err
+		}
+
+		unscaled := internalUtils.RoundRat(r, scale, mode)
+		if !internalUtils.FitsInPrecision(unscaled, precision) {
+			return nil, fmt.Errorf("%s does not fit in %s", r.FloatString(int(scale)), dtype)
+		}
+
+		switch b := builder.(type) {
+		case *array.Decimal128Builder:
+			b.Append(decimal128.FromBigInt(unscaled))
+		case *array.Decimal256Builder:
+			b.Append(decimal256.FromBigInt(unscaled))
+		}
+	}
+
+	return builder.NewArray(), nil
+}
+
+// FormatDecimal formats a decimal array as a String array with all the digits of its scale. Nulls stay null.
+func FormatDecimal(ctx context.Context, arr arrow.Array, mem memory.Allocator) (arrow.Array, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var format func(i int) string
+	switch a := arr.(type) {
+	case *array.Decimal128:
+		scale := a.DataType().(*arrow.Decimal128Type).Scale
+		format = func(i int) string { return a.Value(i).ToString(scale) }
+	case *array.Decimal256:
+		scale := a.DataType().(*arrow.Decimal256Type).Scale
+		format = func(i int) string { return a.Value(i).ToString(scale) }
+	default:
+		return nil, fmt.Errorf("cannot format %s as decimal strings", arr.DataType())
+	}
+
+	builder := array.NewStringBuilder(mem)
+	defer builder.Release()
+	builder.Reserve(arr.Len())
+
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			builder.AppendNull()
+			continue
+		}
+		builder.Append(format(i))
+	}
+
+	return builder.NewArray(), nil
+}
+
+// ParseDecimalScalar parses a decimal literal such as "12.345" or "-1e-3" into a decimal scalar
+// with exactly the scale the literal needs, so that it compares exactly with a decimal array of any scale.
+func ParseDecimalScalar(v string) (scalar.Scalar, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(v))
+	if !ok {
+		return nil, fmt.Errorf("cannot parse %q as a decimal", v)
+	}
+
+	// The scale is the smallest one that represents the literal exactly
+	var scale int32
+	for scaled := new(big.Rat).Set(r); !scaled.IsInt(); scale++ {
+		if scale == decimal256.MaxPrecision {
+			return nil, fmt.Errorf("%q has too many fractional digits for a decimal", v)
+		}
+		scaled.Mul(scaled, big.NewRat(10, 1))
+	}
+
+	unscaled := internalUtils.RoundRat(r, scale, utils.RoundDown)
+	switch {
+	case internalUtils.FitsInPrecision(unscaled, decimal128.MaxPrecision):
+		return newDecimalScalar(unscaled, decimal128.MaxPrecision, scale), nil
+	case internalUtils.FitsInPrecision(unscaled, decimal256.MaxPrecision):
+		return newDecimalScalar(unscaled, decimal256.MaxPrecision, scale), nil
+	default:
+		return nil, fmt.Errorf("%q does not fit in a decimal", v)
+	}
+}
+
+// ratReader returns a function reading the valid element i of a numeric, string or decimal array as an exact rational.
+func ratReader(arr arrow.Array) (func(i int) (*big.Rat, error), error) {
+	integer := func(v int64) (*big.Rat, error) { return new(big.Rat).SetInt64(v), nil }
+
+	switch a := arr.(type) {
+	case *array.Int8:
+		return func(i int) (*big.Rat, error) { return integer(int64(a.Value(i))) }, nil
+	case *array.Int16:
+		return func(i int) (*big.Rat, error) { return integer(int64(a.Value(i))) }, nil
+	case *array.Int32:
+		return func(i int) (*big.Rat, error) { return integer(int64(a.Value(i))) }, nil
+	case *array.Int64:
+		return func(i int) (*big.Rat, error) { return integer(a.Value(i)) }, nil
+	case *array.Uint8:
+		return func(i int) (*big.Rat, error) { return integer(int64(a.Value(i))) }, nil
+	case *array.Uint16:
+		return func(i int) (*big.Rat, error) { return integer(int64(a.Value(i))) }, nil
+	case *array.Uint32:
+		return func(i int) (*big.Rat, error) { return integer(int64(a.Value(i))) }, nil
+	case *array.Uint64:
+		return func(i int) (*big.Rat, error) {
+			return new(big.Rat).SetInt(new(big.Int).SetUint64(a.Value(i))), nil
+		}, nil
+	case *array.Float32:
+		return func(i int) (*big.Rat, error) { return floatRat(float64(a.Value(i))) }, nil
+	case *array.Float64:
+		return func(i int) (*big.Rat, error) { return floatRat(a.Value(i)) }, nil
+	case internalUtils.StringArray:
+		return func(i int) (*big.Rat, error) {
+			r, ok := new(big.Rat).SetString(strings.TrimSpace(a.Value(i)))
+			if !ok {
+				return nil, fmt.Errorf("cannot parse %q as a decimal", a.Value(i))
+			}
+			return r, nil
+		}, nil
+	case *array.Decimal128:
+		den := internalUtils.Pow10(a.DataType().(*arrow.Decimal128Type).Scale)
+		return func(i int) (*big.Rat, error) { return new(big.Rat).SetFrac(a.Value(i).BigInt(), den), nil }, nil
+	case *array.Decimal256:
+		den := internalUtils.Pow10(a.DataType().(*arrow.Decimal256Type).Scale)
+		return func(i int) (*big.Rat, error) { return new(big.Rat).SetFrac(a.Value(i).BigInt(), den), nil }, nil
+	default:
+		return nil, fmt.Errorf("cannot cast %s to a decimal", arr.DataType())
+	}
+}
+
+func floatRat(v float64) (*big.Rat, error) {
+	// SetFloat64 is exact, so the rounding mode alone decides the result
+	r := new(big.Rat).SetFloat64(v)
+	if r == nil {
+		return nil, fmt.Errorf("cannot cast %v to a decimal", v)
+	}
+
+	return r, nil
+}
+
+// newDecimalScalar returns the unscaled value as a Decimal128 scalar if precision is at most 38
+// and as a Decimal256 scalar otherwise. The value must fit in the precision.
+func newDecimalScalar(unscaled *big.Int, precision, scale int32) scalar.Scalar {
+	if precision <= decimal128.MaxPrecision {
+		dtype := &arrow.Decimal128Type{Precision: precision, Scale: scale}
+		return scalar.NewDecimal128Scalar(decimal128.FromBigInt(unscaled), dtype)
+	}
+
+	dtype := &arrow.Decimal256Type{Precision: precision, Scale: scale}
+	return scalar.NewDecimal256Scalar(decimal256.FromBigInt(unscaled), dtype)
+}
+
+// decimalString formats an unscaled value with scale fractional digits.
+func decimalString(unscaled *big.Int, scale int32) string {
+	return new(big.Rat).SetFrac(unscaled, internalUtils.Pow10(scale)).FloatString(int(scale))
+}
@@ -0,0 +1,150 @@
+package array
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/compute"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/arrow/scalar"
+
+	"github.com/SHIMA0111/gleam/gleam/utils"
+	internalUtils "github.com/SHIMA0111/gleam/internal/utils"
+)
+
+// IsDictionary reports whether the type is a dictionary (categorical) type.
+func IsDictionary(dtype arrow.DataType) bool {
+	return dtype.ID() == arrow.DICTIONARY
+}
+
+// EncodeDictionary dictionary-encodes an array of the value type of dtype, in the order the values first appear.
+// Nulls stay null and are not added to the dictionary.
+func EncodeDictionary(ctx context.Context, arr arrow.Array, dtype *arrow.DictionaryType, mem memory.Allocator) (arrow.Array, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if !arrow.TypeEqual(arr.DataType(), dtype.ValueType) {
+		return nil, fmt.Errorf("cannot encode %s as %s", arr.DataType(), dtype)
+	}
+
+	builder := array.NewDictionaryBuilder(mem, dtype)
+	defer builder.Release()
+
+	if err := builder.AppendArray(arr); err != nil {
+		return nil, err
+	}
+
+	return builder.NewArray(), nil
+}
+
+// DictionaryComparison compares every element of a dictionary array with the value, which has the value type
+// of the dictionary. The comparison is evaluated once per dictionary entry and gathered by the element codes,
+// so it costs one comparison per distinct value instead of one per element.
+func DictionaryComparison(
+	ctx context.Context,
+	arr *array.Dictionary,
+	cond utils.CompareOperand,
+	value scalar.Scalar,
+) (arrow.Array, error) {
+	entryResults, err := Comparison(ctx, arr.Dictionary(), cond, value)
+	if err != nil {
+		return nil, err
+	}
+	defer entryResults.Release()
+
+	return compute.TakeArray(ctx, entryResults, arr.Indices())
+}
+
+// FilterDictionary is Filter for dictionary arrays: the codes are filtered and the dictionary is shared.
+func FilterDictionary(
+	ctx context.Context,
+	arr *array.Dictionary,
+	filterArr arrow.Array,
+	filterOpts compute.FilterOptions,
+) (arrow.Array, error) {
+	indices, err := Filter(ctx, arr.Indices(), filterArr, filterOpts)
+	if err != nil {
+		return nil, err
+	}
+	defer indices.Release()
+
+	return array.NewDictionaryArray(arr.DataType(), indices, arr.Dictionary()), nil
+}
+
+// TakeDictionary gathers the elements of a dictionary array at the indices, which arrow cannot take directly.
+// The codes are taken instead and the result shares the dictionary of the input.
+func TakeDictionary(ctx context.Context, arr *array.Dictionary, indices arrow.Array) (arrow.Array, error) {
+	codes, err := compute.TakeArray(ctx, arr.Indices(), indices)
+	if err != nil {
+		return nil, err
+	}
+	defer codes.Release()
+
+	return array.NewDictionaryArray(arr.DataType(), codes, arr.Dictionary()), nil
+}
+
+// MakeArrayFromScalar is scalar.MakeArrayFromScalar that also supports dictionary scalars,
+// which arrow cannot repeat into an array.
+func MakeArrayFromScalar(scl scalar.Scalar, n int, mem memory.Allocator) (arrow.Array, error) {
+	dictScalar, ok := scl.(*scalar.Dictionary)
+	if !ok {
+		return scalar.MakeArrayFromScalar(scl, n, mem)
+	}
+
+	if !dictScalar.IsValid() {
+		return array.MakeArrayOfNull(mem, dictScalar.DataType(), n), nil
+	}
+
+	indices, err := scalar.MakeArrayFromScalar(dictScalar.Value.Index, n, mem)
+	if err != nil {
+		return nil, err
+	}
+	defer indices.Release()
+
+	return array.NewDictionaryArray(dictScalar.DataType(), indices, dictScalar.Value.Dict), nil
+}
+
+// dictionaryExtremum returns the element of the dictionary array whose value compares best, as a dictionary scalar,
+// or nil if every element is null. better reports whether the comparison result of a candidate against the current
+// best makes it the new best. Each distinct code is compared once.
+func dictionaryExtremum(arr *array.Dictionary, better func(cmp int) bool) (scalar.Scalar, error) {
+	dict := arr.Dictionary()
+	// firstRow is the first row holding each code, or -1 if the code is not used
+	firstRow := make([]int, dict.Len())
+	for i := range firstRow {
+		firstRow[i] = -1
+	}
+
+	internalUtils.VisitValidRuns(arr, func(start, end int) {
+		for i := start; i < end; i++ {
+			if code := arr.GetValueIndex(i); firstRow[code] < 0 {
+				firstRow[code] = i
+			}
+		}
+	})
+
+	bestRow := -1
+	var bestValue scalar.Scalar
+	for code, row := range firstRow {
+		if row < 0 || dict.IsNull(code) {
+			continue
+		}
+
+		value, err := scalar.GetScalar(dict, code)
+		if err != nil {
+			return nil, err
+		}
+		if bestRow < 0 || better(compareScalars(value, bestValue)) {
+			bestRow, bestValue = row, value
+		}
+	}
+
+	if bestRow < 0 {
+		return nil, nil
+	}
+
+	return scalar.GetScalar(arr, bestRow)
+}
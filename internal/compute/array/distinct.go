@@ -0,0 +1,187 @@
+package array
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+
+	"github.com/SHIMA0111/gleam/internal/compute/parallel"
+)
+
+// Distinct finds the distinct elements of the array, null being one of them, with a hash table keyed by
+// the bytes of the elements in the arrow buffers. It returns the position of the first appearance of every
+// distinct element, in order of first appearance, and the number of times it appears.
+// The array is hashed in ranges of chunkSize elements concurrently, or in one piece if chunkSize is 0.
+// Float values are hashed by value, so 0 and -0 are the same element, as are all NaN values.
+// Returns an error if the elements of the array cannot be hashed, e.g. lists and structs.
+func Distinct(ctx context.Context, arr arrow.Array, chunkSize int) (first []int64, counts []int64, err error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	keyOf, err := distinctKey(arr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	partials, err := parallel.MapRanges(ctx, arr.Len(), chunkSize, func(ctx context.Context, start, end int) (*distinctState, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		state := newDistinctState()
+		for i := start; i < end; i++ {
+			if arr.IsNull(i) {
+				state.addNull(int64(i), 1)
+				continue
+			}
+			state.add(keyOf(i), int64(i), 1)
+		}
+
+		return state, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The ranges are merged in order, so the entries stay in order of first appearance
+	result := partials[0]
+	for _, partial := range partials[1:] {
+		for k, key := range partial.keys {
+			result.add(key, partial.first[k], partial.counts[k])
+		}
+		if partial.nullCount > 0 {
+			result.addNull(partial.nullFirst, partial.nullCount)
+		}
+	}
+
+	first, counts = result.entries()
+	return first, counts, nil
+}
+
+// distinctState is the hash table of the distinct elements of a range of an array.
+type distinctState struct {
+	index     map[string]int
+	keys      []string
+	first     []int64
+	counts    []int64
+	nullFirst int64
+	nullCount int64
+}
+
+func newDistinctState() *distinctState {
+	return &distinctState{index: make(map[string]int)}
+}
+
+// add counts count appearances of the element key, first appearing at position.
+func (d *distinctState) add(key string, position int64, count int64) {
+	if k, ok := d.index[key]; ok {
+		d.counts[k] += count
+		return
+	}
+
+	d.index[key] = len(d.keys)
+	d.keys = append(d.keys, key)
+	d.first = append(d.first, position)
+	d.counts = append(d.counts, count)
+}
+
+// addNull counts count appearances of null, first appearing at position.
+func (d *distinctState) addNull(position int64, count int64) {
+	if d.nullCount == 0 {
+		d.nullFirst = position
+	}
+	d.nullCount += count
+}
+
+// entries returns the first positions and counts of the distinct elements, with null in its place if any.
+func (d *distinctState) entries() (first []int64, counts []int64) {
+	if d.nullCount == 0 {
+		return d.first, d.counts
+	}
+
+	k := sort.Search(len(d.first), func(k int) bool { return d.first[k] > d.nullFirst })
+	first = append(d.first[:k:k], append([]int64{d.nullFirst}, d.first[k:]...)...)
+	counts = append(d.counts[:k:k], append([]int64{d.nullCount}, d.counts[k:]...)...)
+
+	return first, counts
+}
+
+// distinctKey returns a function giving the hash key of the valid element i of the array.
+func distinctKey(arr arrow.Array) (func(i int) string, error) {
+	switch a := arr.(type) {
+	case *array.Null:
+		return func(int) string { return "" }, nil
+	case *array.Boolean:
+		return func(i int) string {
+			if a.Value(i) {
+				return "1"
+			}
+			return "0"
+		}, nil
+	case *array.Float32:
+		return func(i int) string {
+			v := a.Value(i)
+			switch {
+			case math.IsNaN(float64(v)):
+				v = float32(math.NaN())
+			case v == 0:
+				v = 0
+			}
+			return string(binary.LittleEndian.AppendUint32(nil, math.Float32bits(v)))
+		}, nil
+	case *array.Float64:
+		return func(i int) string {
+			v := a.Value(i)
+			switch {
+			case math.IsNaN(v):
+				v = math.NaN()
+			case v == 0:
+				v = 0
+			}
+			return string(binary.LittleEndian.AppendUint64(nil, math.Float64bits(v)))
+		}, nil
+	case *array.String:
+		return a.Value, nil
+	case *array.LargeString:
+		return a.Value, nil
+	case *array.Binary:
+		return func(i int) string { return string(a.Value(i)) }, nil
+	case *array.LargeBinary:
+		return func(i int) string { return string(a.Value(i)) }, nil
+	case *array.FixedSizeBinary:
+		return func(i int) string { return string(a.Value(i)) }, nil
+	case *array.Dictionary:
+		// The elements are keyed by their values, so that equal categories with different codes are one element
+		valueKey, err := distinctKey(a.Dictionary())
+		if err != nil {
+			return nil, err
+		}
+		return func(i int) string { return valueKey(a.GetValueIndex(i)) }, nil
+	}
+
+	// Integer, temporal and decimal elements are keyed by their bytes in the values buffer
+	fixedWidth, ok := arr.DataType().(arrow.FixedWidthDataType)
+	if !ok || fixedWidth.BitWidth()%8 != 0 || len(arr.Data().Buffers()) != 2 {
+		return nil, fmt.Errorf("cannot find distinct values of %s array", arr.DataType())
+	}
+
+	// An empty array may have no values buffer, and has no element to key
+	if arr.Len() == 0 || arr.Data().Buffers()[1] == nil {
+		return func(int) string { return "" }, nil
+	}
+
+	width := fixedWidth.BitWidth() / 8
+	values := arr.Data().Buffers()[1].Bytes()
+	offset := arr.Data().Offset()
+
+	return func(i int) string {
+		start := (offset + i) * width
+		return string(values[start : start+width])
+	}, nil
+}
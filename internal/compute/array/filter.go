@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
 	"github.com/apache/arrow-go/v18/arrow/compute"
 )
 
@@ -14,11 +15,18 @@ import (
 // Returns an error if the lengths of the input array and filter array differ.
 // Also returns an error if the filter array is not of a boolean type or contains null values.
 func Filter(ctx context.Context, arr arrow.Array, filterArr arrow.Array, filterOpts compute.FilterOptions) (arrow.Array, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// The caller releases the returned array, so the input is retained when it is returned as is
 	if arr.Len() == 0 {
+		arr.Retain()
 		return arr, nil
 	}
 
 	if filterArr.Len() == 0 {
+		arr.Retain()
 		return arr, nil
 	}
 
@@ -30,6 +38,11 @@ func Filter(ctx context.Context, arr arrow.Array, filterArr arrow.Array, filterO
 		return nil, fmt.Errorf("array length is not equal to filter array length: %d != %d", arr.Len(), filterArr.Len())
 	}
 
+	// Arrow cannot filter dictionary arrays, so their codes are filtered instead
+	if dictArr, ok := arr.(*array.Dictionary); ok {
+		return FilterDictionary(ctx, dictArr, filterArr, filterOpts)
+	}
+
 	arrDatum := compute.NewDatum(arr)
 	defer arrDatum.Release()
 
@@ -0,0 +1,147 @@
+package array
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/bitutil"
+	"github.com/apache/arrow-go/v18/arrow/compute"
+)
+
+// MaskOp is an element-wise logical operation between two boolean masks.
+type MaskOp int
+
+const (
+	MaskAnd MaskOp = iota
+	MaskOr
+	MaskXor
+	// MaskAndNot is true where the left mask is true and the right mask is false.
+	MaskAndNot
+)
+
+func (op MaskOp) String() string {
+	switch op {
+	case MaskAnd:
+		return "and"
+	case MaskOr:
+		return "or"
+	case MaskXor:
+		return "xor"
+	case MaskAndNot:
+		return "and_not"
+	default:
+		return "unknown"
+	}
+}
+
+// arrowMaskFunctions are the arrow compute functions of the mask operations, which follow the Kleene logic.
+// Xor has no Kleene variant, as a null operand always leaves its result unknown.
+var arrowMaskFunctions = map[MaskOp]string{
+	MaskAnd:    "and_kleene",
+	MaskOr:     "or_kleene",
+	MaskXor:    "xor",
+	MaskAndNot: "and_not_kleene",
+}
+
+// CombineMasks applies the logical operation to the elements of two boolean arrays at the same positions with
+// the Kleene three-valued logic, where null is an unknown value: false and null is false, true or null is true,
+// and any other operation with null is null.
+// Returns an error if the arrays are not boolean or differ in length.
+func CombineMasks(ctx context.Context, op MaskOp, left, right arrow.Array) (arrow.Array, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if left.DataType().ID() != arrow.BOOL || right.DataType().ID() != arrow.BOOL {
+		return nil, fmt.Errorf("cannot %s %s and %s arrays, masks must be boolean", op, left.DataType(), right.DataType())
+	}
+
+	if left.Len() != right.Len() {
+		return nil, fmt.Errorf("array length is not equal to the other array length: %d != %d", left.Len(), right.Len())
+	}
+
+	name, ok := arrowMaskFunctions[op]
+	if !ok {
+		return nil, fmt.Errorf("unknown mask operation %d", op)
+	}
+
+	leftDatum := compute.NewDatum(left)
+	defer leftDatum.Release()
+	rightDatum := compute.NewDatum(right)
+	defer rightDatum.Release()
+
+	resultDatum, err := compute.CallFunction(ctx, name, nil, leftDatum, rightDatum)
+	if err != nil {
+		return nil, err
+	}
+	defer resultDatum.Release()
+
+	resultArray, ok := resultDatum.(*compute.ArrayDatum)
+	if !ok {
+		return nil, fmt.Errorf("%s did not return an array datum", op)
+	}
+
+	return resultArray.MakeArray(), nil
+}
+
+// InvertMask negates every element of a boolean array; null stays null.
+// Returns an error if the array is not boolean.
+func InvertMask(ctx context.Context, arr arrow.Array) (arrow.Array, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if arr.DataType().ID() != arrow.BOOL {
+		return nil, fmt.Errorf("cannot invert %s array, masks must be boolean", arr.DataType())
+	}
+
+	arrDatum := compute.NewDatum(arr)
+	defer arrDatum.Release()
+
+	resultDatum, err := compute.CallFunction(ctx, "not", nil, arrDatum)
+	if err != nil {
+		return nil, err
+	}
+	defer resultDatum.Release()
+
+	resultArray, ok := resultDatum.(*compute.ArrayDatum)
+	if !ok {
+		return nil, fmt.Errorf("not did not return an array datum")
+	}
+
+	return resultArray.MakeArray(), nil
+}
+
+// CountMask counts the true and the false elements of a boolean array; nulls are in neither count.
+// Returns an error if the array is not boolean.
+func CountMask(ctx context.Context, arr arrow.Array) (trues int, falses int, err error) {
+	if err := ctx.Err(); err != nil {
+		return 0, 0, err
+	}
+
+	boolArr, ok := arr.(*array.Boolean)
+	if !ok {
+		return 0, 0, fmt.Errorf("cannot count %s array, masks must be boolean", arr.DataType())
+	}
+
+	length := boolArr.Len()
+	if length == 0 {
+		return 0, 0, nil
+	}
+
+	offset := boolArr.Data().Offset()
+	values := boolArr.Data().Buffers()[1].Bytes()
+	if boolArr.NullN() == 0 {
+		trues = bitutil.CountSetBits(values, offset, length)
+		return trues, length - trues, nil
+	}
+
+	// A valid true element has both its validity and value bits set
+	validTrue := make([]byte, bitutil.BytesForBits(int64(length)))
+	bitutil.BitmapAnd(boolArr.NullBitmapBytes(), values, int64(offset), int64(offset), validTrue, 0, int64(length))
+	trues = bitutil.CountSetBits(validTrue, 0, length)
+
+	return trues, length - boolArr.NullN() - trues, nil
+}
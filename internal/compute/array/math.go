@@ -0,0 +1,406 @@
+package array
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/bitutil"
+	"github.com/apache/arrow-go/v18/arrow/compute"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/arrow/scalar"
+
+	"github.com/SHIMA0111/gleam/gleam/utils"
+	internalUtils "github.com/SHIMA0111/gleam/internal/utils"
+)
+
+// MathFunc is an element-wise math function of one numeric operand.
+type MathFunc int
+
+const (
+	// MathAbs is the absolute value, of the type of the operand.
+	MathAbs MathFunc = iota
+	// MathSign is -1, 0 or 1 by the sign of the operand: Int8 for integers, and of the type of a float,
+	// for which NaN stays NaN.
+	MathSign
+	// MathFloor rounds towards negative infinity, of the type of the operand.
+	MathFloor
+	// MathCeil rounds towards positive infinity, of the type of the operand.
+	MathCeil
+	MathSqrt
+	MathExp
+	// MathLog is the natural logarithm.
+	MathLog
+	MathLog10
+	// MathLog1p is the natural logarithm of 1 plus the operand, which is exact near 0.
+	MathLog1p
+	MathSin
+	MathCos
+	MathTan
+	MathAsin
+	MathAcos
+	MathAtan
+)
+
+func (f MathFunc) String() string {
+	switch f {
+	case MathAbs:
+		return "abs"
+	case MathSign:
+		return "sign"
+	case MathFloor:
+		return "floor"
+	case MathCeil:
+		return "ceil"
+	case MathSqrt:
+		return "sqrt"
+	case MathExp:
+		return "exp"
+	case MathLog:
+		return "log"
+	case MathLog10:
+		return "log10"
+	case MathLog1p:
+		return "log1p"
+	case MathSin:
+		return "sin"
+	case MathCos:
+		return "cos"
+	case MathTan:
+		return "tan"
+	case MathAsin:
+		return "asin"
+	case MathAcos:
+		return "acos"
+	case MathAtan:
+		return "atan"
+	default:
+		return "unknown"
+	}
+}
+
+// arrowMathFunctions are the arrow compute functions of the math functions arrow implements.
+// The unchecked variants are used, so that a value outside the domain gives NaN as in IEEE 754 instead of an error.
+var arrowMathFunctions = map[MathFunc]string{
+	MathAbs:   "abs",
+	MathSign:  "sign",
+	MathFloor: "floor",
+	MathCeil:  "ceil",
+	MathSqrt:  "sqrt_unchecked",
+	MathLog:   "ln_unchecked",
+	MathLog10: "log10_unchecked",
+	MathLog1p: "log1p_unchecked",
+	MathSin:   "sin_unchecked",
+	MathCos:   "cos_unchecked",
+	MathTan:   "tan_unchecked",
+	MathAsin:  "asin_unchecked",
+	MathAcos:  "acos_unchecked",
+	MathAtan:  "atan",
+}
+
+// Math applies the math function to every element of a numeric array. Nulls propagate.
+// The functions other than MathAbs, MathSign, MathFloor and MathCeil compute in floats: a Float32 array gives
+// Float32 and any other array Float64, and a value outside the domain of the function gives NaN.
+// Returns an error if the array is not numeric, or if MathAbs overflows, i.e. for the minimum value of an integer type.
+func Math(ctx context.Context, fn MathFunc, arr arrow.Array, mem memory.Allocator) (arrow.Array, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if !IsNumeric(arr.DataType()) {
+		return nil, fmt.Errorf("cannot apply %s to %s array", fn, arr.DataType())
+	}
+
+	// Integers are already whole, while arrow would return them as Float64
+	if (fn == MathFloor || fn == MathCeil) && arrow.IsInteger(arr.DataType().ID()) {
+		arr.Retain()
+		return arr, nil
+	}
+
+	// Arrow has no exponential function
+	if fn == MathExp {
+		return floatMath(ctx, arr, math.Exp, mem)
+	}
+
+	name, ok := arrowMathFunctions[fn]
+	if !ok {
+		return nil, fmt.Errorf("unknown math function %d", fn)
+	}
+
+	arrDatum := compute.NewDatum(arr)
+	defer arrDatum.Release()
+
+	resultDatum, err := compute.CallFunction(ctx, name, nil, arrDatum)
+	if err != nil {
+		return nil, fmt.Errorf("cannot apply %s to %s array: %w", fn, arr.DataType(), err)
+	}
+	defer resultDatum.Release()
+
+	resultArray, ok := resultDatum.(*compute.ArrayDatum)
+	if !ok {
+		return nil, fmt.Errorf("%s did not return an array datum", fn)
+	}
+
+	return resultArray.MakeArray(), nil
+}
+
+// Round rounds every element of a numeric array to the number of decimal places with the rounding mode;
+// a negative number rounds to a power of ten, e.g. -2 to hundreds. The result has the type of the array, and
+// nulls propagate. Integers are rounded exactly and are unchanged by a non-negative number of decimal places.
+// Returns an error if the array is not numeric, or if a rounded integer does not fit in its type.
+func Round(ctx context.Context, arr arrow.Array, decimals int32, mode utils.RoundingMode, mem memory.Allocator) (arrow.Array, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	dtype := arr.DataType()
+	switch {
+	case !IsNumeric(dtype):
+		return nil, fmt.Errorf("cannot round %s array", dtype)
+	case arrow.IsFloating(dtype.ID()):
+		arrDatum := compute.NewDatum(arr)
+		defer arrDatum.Release()
+
+		resultDatum, err := compute.Round(ctx, compute.RoundOptions{NDigits: int64(decimals), Mode: arrowRoundMode(mode)}, arrDatum)
+		if err != nil {
+			return nil, err
+		}
+		defer resultDatum.Release()
+
+		resultArray, ok := resultDatum.(*compute.ArrayDatum)
+		if !ok {
+			return nil, fmt.Errorf("round did not return an array datum")
+		}
+
+		return resultArray.MakeArray(), nil
+	case decimals >= 0:
+		arr.Retain()
+		return arr, nil
+	}
+
+	switch dtype.ID() {
+	case arrow.INT8:
+		return roundIntegers[int8](arr, decimals, mode, mem)
+	case arrow.INT16:
+		return roundIntegers[int16](arr, decimals, mode, mem)
+	case arrow.INT32:
+		return roundIntegers[int32](arr, decimals, mode, mem)
+	case arrow.INT64:
+		return roundIntegers[int64](arr, decimals, mode, mem)
+	case arrow.UINT8:
+		return roundIntegers[uint8](arr, decimals, mode, mem)
+	case arrow.UINT16:
+		return roundIntegers[uint16](arr, decimals, mode, mem)
+	case arrow.UINT32:
+		return roundIntegers[uint32](arr, decimals, mode, mem)
+	default:
+		return roundIntegers[uint64](arr, decimals, mode, mem)
+	}
+}
+
+// arrowRoundMode returns the arrow rounding mode of the rounding mode.
+func arrowRoundMode(mode utils.RoundingMode) compute.RoundMode {
+	switch mode {
+	case utils.RoundHalfUp:
+		return compute.RoundHalfTowardsInfinity
+	case utils.RoundHalfDown:
+		return compute.RoundHalfTowardsZero
+	case utils.RoundDown:
+		return compute.RoundTowardsZero
+	case utils.RoundUp:
+		return compute.RoundTowardsInfinity
+	case utils.RoundFloor:
+		return compute.RoundDown
+	case utils.RoundCeiling:
+		return compute.RoundUp
+	default:
+		return compute.RoundHalfToEven
+	}
+}
+
+// roundIntegers rounds the integers of the type of T to a negative number of decimal places.
+func roundIntegers[T internalUtils.Numeric](arr arrow.Array, decimals int32, mode utils.RoundingMode, mem memory.Allocator) (arrow.Array, error) {
+	kind := kindOf[T]()
+	minValue, maxValue := limits[T]()
+	lower, upper := bigOf(minValue, kind), bigOf(maxValue, kind)
+	unit := internalUtils.Pow10(-decimals)
+
+	return unaryValues(arr, arr.DataType(), func(v T) (T, error) {
+		r := internalUtils.RoundRat(new(big.Rat).SetInt(bigOf(v, kind)), decimals, mode)
+		r.Mul(r, unit)
+		if r.Cmp(lower) < 0 || r.Cmp(upper) > 0 {
+			return 0, fmt.Errorf("integer overflow in %s: round(%v, %d)", arr.DataType(), v, decimals)
+		}
+		if kind == unsignedKind {
+			return T(r.Uint64()), nil
+		}
+		return T(r.Int64()), nil
+	}, mem)
+}
+
+// Clip limits every element of a numeric array to the range from lo to hi, where a nil bound leaves that side
+// unbounded. The result has the type of the array; nulls and NaN values propagate.
+// Returns an error if the array is not numeric, if a bound is not a number that fits in the type of the array,
+// is null or NaN, or if lo is greater than hi.
+func Clip(ctx context.Context, arr arrow.Array, lo, hi scalar.Scalar, mem memory.Allocator) (arrow.Array, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if !IsNumeric(arr.DataType()) {
+		return nil, fmt.Errorf("cannot clip %s array", arr.DataType())
+	}
+
+	switch arr.DataType().ID() {
+	case arrow.INT8:
+		return clipValues[int8](arr, lo, hi, mem)
+	case arrow.INT16:
+		return clipValues[int16](arr, lo, hi, mem)
+	case arrow.INT32:
+		return clipValues[int32](arr, lo, hi, mem)
+	case arrow.INT64:
+		return clipValues[int64](arr, lo, hi, mem)
+	case arrow.UINT8:
+		return clipValues[uint8](arr, lo, hi, mem)
+	case arrow.UINT16:
+		return clipValues[uint16](arr, lo, hi, mem)
+	case arrow.UINT32:
+		return clipValues[uint32](arr, lo, hi, mem)
+	case arrow.UINT64:
+		return clipValues[uint64](arr, lo, hi, mem)
+	case arrow.FLOAT32:
+		return clipValues[float32](arr, lo, hi, mem)
+	default:
+		return clipValues[float64](arr, lo, hi, mem)
+	}
+}
+
+// clipValues clips the values of the type of T, which is also the result type.
+func clipValues[T internalUtils.Numeric](arr arrow.Array, lo, hi scalar.Scalar, mem memory.Allocator) (arrow.Array, error) {
+	minValue, maxValue := limits[T]()
+	if kindOf[T]() == floatKind {
+		minValue, maxValue = T(math.Inf(-1)), T(math.Inf(1))
+	}
+
+	var err error
+	if lo != nil {
+		if minValue, err = clipBound[T](lo, arr.DataType(), mem); err != nil {
+			return nil, err
+		}
+	}
+	if hi != nil {
+		if maxValue, err = clipBound[T](hi, arr.DataType(), mem); err != nil {
+			return nil, err
+		}
+	}
+	if minValue > maxValue {
+		return nil, fmt.Errorf("clip lower bound %v is greater than upper bound %v", minValue, maxValue)
+	}
+
+	return unaryValues(arr, arr.DataType(), func(v T) (T, error) {
+		// NaN compares false with both bounds, so it is kept
+		switch {
+		case v < minValue:
+			return minValue, nil
+		case v > maxValue:
+			return maxValue, nil
+		default:
+			return v, nil
+		}
+	}, mem)
+}
+
+// clipBound returns the value of a clip bound in the type of the array, dtype, of which T is the value type.
+func clipBound[T internalUtils.Numeric](bound scalar.Scalar, dtype arrow.DataType, mem memory.Allocator) (T, error) {
+	if !IsNumeric(bound.DataType()) || !bound.IsValid() {
+		return 0, fmt.Errorf("cannot clip %s array to %s", dtype, bound)
+	}
+	if !arrow.TypeEqual(literalType(bound, dtype), dtype) {
+		return 0, fmt.Errorf("clip bound %s does not fit in %s", bound, dtype)
+	}
+
+	casted, err := bound.CastTo(dtype)
+	if err != nil {
+		return 0, err
+	}
+
+	boundArr, err := scalar.MakeArrayFromScalar(casted, 1, mem)
+	if err != nil {
+		return 0, err
+	}
+	defer boundArr.Release()
+
+	value := numericValues[T](boundArr)[0]
+	if value != value {
+		return 0, fmt.Errorf("clip bound cannot be NaN")
+	}
+
+	return value, nil
+}
+
+// floatMath applies fn to every element of a numeric array in floats: Float32 for a Float32 array
+// and Float64 for any other array.
+func floatMath(ctx context.Context, arr arrow.Array, fn func(float64) float64, mem memory.Allocator) (arrow.Array, error) {
+	if arr.DataType().ID() == arrow.FLOAT32 {
+		return unaryValues(arr, arr.DataType(), func(v float32) (float32, error) {
+			return float32(fn(float64(v))), nil
+		}, mem)
+	}
+
+	floats, err := castNumeric(ctx, arr, arrow.PrimitiveTypes.Float64)
+	if err != nil {
+		return nil, err
+	}
+	defer floats.Release()
+
+	return unaryValues(floats, arrow.PrimitiveTypes.Float64, func(v float64) (float64, error) {
+		return fn(v), nil
+	}, mem)
+}
+
+// unaryValues applies fn to every valid element of a numeric array of the type of T and returns the results as
+// an array of type dtype, whose value type is R. The null bitmap of the array is kept.
+func unaryValues[T, R internalUtils.Numeric](
+	arr arrow.Array,
+	dtype arrow.DataType,
+	fn func(T) (R, error),
+	mem memory.Allocator,
+) (arrow.Array, error) {
+	length := arr.Len()
+	if length == 0 {
+		return array.MakeArrayOfNull(mem, dtype, 0), nil
+	}
+	inValues := numericValues[T](arr)
+
+	values := memory.NewResizableBuffer(mem)
+	defer values.Release()
+	values.Resize(length * bitWidth(dtype) / 8)
+	out := arrow.GetData[R](values.Bytes())
+
+	var nullBitmap *memory.Buffer
+	if arr.NullN() > 0 {
+		nullBitmap = memory.NewResizableBuffer(mem)
+		defer nullBitmap.Release()
+		nullBitmap.Resize(int(bitutil.BytesForBits(int64(length))))
+		bitutil.CopyBitmap(arr.NullBitmapBytes(), arr.Data().Offset(), length, nullBitmap.Bytes(), 0)
+	}
+
+	for i, v := range inValues {
+		if arr.IsNull(i) {
+			continue
+		}
+		r, err := fn(v)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = r
+	}
+
+	data := array.NewData(dtype, length, []*memory.Buffer{nullBitmap, values}, nil, arr.NullN(), 0)
+	defer data.Release()
+
+	return array.MakeFromData(data), nil
+}
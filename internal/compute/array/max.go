@@ -6,19 +6,51 @@ import (
 
 	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/decimal128"
+	"github.com/apache/arrow-go/v18/arrow/decimal256"
 	"github.com/apache/arrow-go/v18/arrow/memory"
 	"github.com/apache/arrow-go/v18/arrow/scalar"
 
 	"github.com/SHIMA0111/gleam/internal/utils"
 )
 
+// MaxState is the partial maximum of an array. Value is nil while no non-null value has been seen,
+// so the partial states of all-null chunks can be merged as well.
+type MaxState struct {
+	Value scalar.Scalar
+}
+
+// Merge combines two partial maximums of the same input type. A NaN partial result propagates.
+func (s MaxState) Merge(other MaxState) MaxState {
+	if isNaNScalar(s.Value) {
+		return s
+	}
+	if isNaNScalar(other.Value) {
+		return other
+	}
+	if s.Value == nil || (other.Value != nil && compareScalars(other.Value, s.Value) > 0) {
+		return other
+	}
+
+	return s
+}
+
+// Scalar returns the maximum, or a null scalar of the given type if every value was null.
+func (s MaxState) Scalar(dtype arrow.DataType) scalar.Scalar {
+	if s.Value == nil {
+		return scalar.MakeNullScalar(dtype)
+	}
+
+	return s.Value
+}
+
 func MaxArray(ctx context.Context, arr arrow.Array, mem memory.Allocator) (arrow.Array, error) {
-	sumScl, err := Max(ctx, arr)
+	maxScl, err := Max(ctx, arr)
 	if err != nil {
 		return nil, err
 	}
 
-	newArray, err := scalar.MakeArrayFromScalar(sumScl, 1, mem)
+	newArray, err := scalar.MakeArrayFromScalar(maxScl, 1, mem)
 	if err != nil {
 		return nil, err
 	}
@@ -30,55 +62,231 @@ func Max(ctx context.Context, arr arrow.Array) (scalar.Scalar, error) {
 	if arr.Len() == 0 {
 		return nil, fmt.Errorf("cannot find max value of empty array")
 	}
-	droppedArray, err := DropNullArray(ctx, arr)
+
+	state, err := MaxStateOf(ctx, arr)
 	if err != nil {
 		return nil, err
 	}
 
+	return state.Scalar(arr.DataType()), nil
+}
+
+// MaxStateOf finds the maximum of the non-null values of the array as a mergeable partial state.
+// Numeric and temporal values compare by value and strings lexicographically by their UTF-8 bytes.
+// Dictionary-encoded values compare by their decoded value, and the result is the dictionary element.
+// The maximum of booleans is true if any value is true. The maximum of float values containing NaN is NaN.
+func MaxStateOf(ctx context.Context, arr arrow.Array) (MaxState, error) {
+	return maxStateOf(ctx, arr, false)
+}
+
+// SkipNaNMaxStateOf is MaxStateOf that leaves NaN values out like nulls.
+// The partial state of an array whose values are all NaN or null is empty.
+func SkipNaNMaxStateOf(ctx context.Context, arr arrow.Array) (MaxState, error) {
+	return maxStateOf(ctx, arr, true)
+}
+
+func maxStateOf(ctx context.Context, arr arrow.Array, skipNaN bool) (MaxState, error) {
+	if err := ctx.Err(); err != nil {
+		return MaxState{}, err
+	}
+
 	var scl scalar.Scalar
 	switch arr.DataType().ID() {
 	case arrow.INT8:
-		val := maxArray[int8](droppedArray.(*array.Int8))
-		scl = scalar.NewInt8Scalar(val)
+		scl = maxScalar[int8](arr, scalar.NewInt8Scalar)
 	case arrow.INT16:
-		val := maxArray[int16](droppedArray.(*array.Int16))
-		scl = scalar.NewInt16Scalar(val)
+		scl = maxScalar[int16](arr, scalar.NewInt16Scalar)
 	case arrow.INT32:
-		val := maxArray[int32](droppedArray.(*array.Int32))
-		scl = scalar.NewInt32Scalar(val)
+		scl = maxScalar[int32](arr, scalar.NewInt32Scalar)
 	case arrow.INT64:
-		val := maxArray[int64](droppedArray.(*array.Int64))
-		scl = scalar.NewInt64Scalar(val)
+		scl = maxScalar[int64](arr, scalar.NewInt64Scalar)
 	case arrow.UINT8:
-		val := maxArray[uint8](droppedArray.(*array.Uint8))
-		scl = scalar.NewUint8Scalar(val)
+		scl = maxScalar[uint8](arr, scalar.NewUint8Scalar)
 	case arrow.UINT16:
-		val := maxArray[uint16](droppedArray.(*array.Uint16))
-		scl = scalar.NewUint16Scalar(val)
+		scl = maxScalar[uint16](arr, scalar.NewUint16Scalar)
 	case arrow.UINT32:
-		val := maxArray[uint32](droppedArray.(*array.Uint32))
-		scl = scalar.NewUint32Scalar(val)
+		scl = maxScalar[uint32](arr, scalar.NewUint32Scalar)
 	case arrow.UINT64:
-		val := maxArray[uint64](droppedArray.(*array.Uint64))
-		scl = scalar.NewUint64Scalar(val)
+		scl = maxScalar[uint64](arr, scalar.NewUint64Scalar)
 	case arrow.FLOAT32:
-		val := maxArray[float32](droppedArray.(*array.Float32))
-		scl = scalar.NewFloat32Scalar(val)
+		scl = maxFloatScalar[float32](arr, scalar.NewFloat32Scalar, skipNaN)
 	case arrow.FLOAT64:
-		val := maxArray[float64](droppedArray.(*array.Float64))
-		scl = scalar.NewFloat64Scalar(val)
+		scl = maxFloatScalar[float64](arr, scalar.NewFloat64Scalar, skipNaN)
+	case arrow.BOOL:
+		scl = maxBoolScalar(arr.(*array.Boolean))
+	case arrow.STRING:
+		scl = maxStringScalar(arr.(*array.String), scalar.NewStringScalar)
+	case arrow.LARGE_STRING:
+		scl = maxStringScalar(arr.(*array.LargeString), scalar.NewLargeStringScalar)
+	case arrow.DATE32:
+		scl = maxScalar[arrow.Date32](arr, scalar.NewDate32Scalar)
+	case arrow.DATE64:
+		scl = maxScalar[arrow.Date64](arr, scalar.NewDate64Scalar)
+	case arrow.TIMESTAMP:
+		scl = maxScalar[arrow.Timestamp](arr, func(v arrow.Timestamp) *scalar.Timestamp {
+			return scalar.NewTimestampScalar(v, arr.DataType())
+		})
+	case arrow.TIME32:
+		scl = maxScalar[arrow.Time32](arr, func(v arrow.Time32) *scalar.Time32 {
+			return scalar.NewTime32Scalar(v, arr.DataType())
+		})
+	case arrow.TIME64:
+		scl = maxScalar[arrow.Time64](arr, func(v arrow.Time64) *scalar.Time64 {
+			return scalar.NewTime64Scalar(v, arr.DataType())
+		})
+	case arrow.DECIMAL128:
+		scl = maxDecimalScalar[decimal128.Num](arr, func(v decimal128.Num) *scalar.Decimal128 {
+			return scalar.NewDecimal128Scalar(v, arr.DataType())
+		})
+	case arrow.DECIMAL256:
+		scl = maxDecimalScalar[decimal256.Num](arr, func(v decimal256.Num) *scalar.Decimal256 {
+			return scalar.NewDecimal256Scalar(v, arr.DataType())
+		})
+	case arrow.DICTIONARY:
+		var err error
+		scl, err = dictionaryExtremum(arr.(*array.Dictionary), func(cmp int) bool { return cmp > 0 })
+		if err != nil {
+			return MaxState{}, err
+		}
+	case arrow.DURATION:
+		scl = maxScalar[arrow.Duration](arr, func(v arrow.Duration) *scalar.Duration {
+			return scalar.NewDurationScalar(v, arr.DataType())
+		})
 	default:
-		return nil, fmt.Errorf("unsupported data type: %s", arr.DataType())
+		return MaxState{}, fmt.Errorf("unsupported data type: %s", arr.DataType())
+	}
+
+	return MaxState{Value: scl}, nil
+}
+
+// maxScalar returns the maximum of the non-null values as a scalar, or nil if there is none.
+// The values are read in place, run by run along the validity bitmap.
+func maxScalar[T utils.Numeric, S scalar.Scalar](arr arrow.Array, newScalar func(T) S) scalar.Scalar {
+	values := arrow.GetValues[T](arr.Data(), 1)
+
+	var maxValue T
+	found := false
+	utils.VisitValidRuns(arr, func(start, end int) {
+		runMax := maxSlice(values[start:end])
+		if !found || runMax > maxValue {
+			maxValue = runMax
+			found = true
+		}
+	})
+
+	if !found {
+		return nil
+	}
+
+	return newScalar(maxValue)
+}
+
+// maxFloatScalar is maxScalar for float arrays. A NaN value is the result unless skipNaN is set,
+// in which case NaN values are ignored like nulls.
+func maxFloatScalar[T float32 | float64, S scalar.Scalar](arr arrow.Array, newScalar func(T) S, skipNaN bool) scalar.Scalar {
+	values := arrow.GetValues[T](arr.Data(), 1)
+
+	var maxValue T
+	found := false
+	nan := false
+	utils.VisitValidRuns(arr, func(start, end int) {
+		if nan {
+			return
+		}
+		for _, v := range values[start:end] {
+			if v != v {
+				if skipNaN {
+					continue
+				}
+				maxValue = v
+				nan = true
+				return
+			}
+			if !found || v > maxValue {
+				maxValue = v
+				found = true
+			}
+		}
+	})
+
+	if !nan && !found {
+		return nil
+	}
+
+	return newScalar(maxValue)
+}
+
+// maxDecimalScalar returns the maximum of the non-null decimal values as a scalar, or nil if there is none.
+func maxDecimalScalar[T decimalNum[T], S scalar.Scalar](arr arrow.Array, newScalar func(T) S) scalar.Scalar {
+	values := arrow.GetValues[T](arr.Data(), 1)
+
+	var maxValue T
+	found := false
+	utils.VisitValidRuns(arr, func(start, end int) {
+		for _, v := range values[start:end] {
+			if !found || v.Greater(maxValue) {
+				maxValue = v
+				found = true
+			}
+		}
+	})
+
+	if !found {
+		return nil
+	}
+
+	return newScalar(maxValue)
+}
+
+// maxBoolScalar returns whether any non-null value is true, or nil if there is none.
+func maxBoolScalar(arr *array.Boolean) scalar.Scalar {
+	found := false
+	result := false
+	utils.VisitValidRuns(arr, func(start, end int) {
+		found = true
+		for i := start; i < end; i++ {
+			if result {
+				break
+			}
+			if arr.Value(i) {
+				result = true
+			}
+		}
+	})
+
+	if !found {
+		return nil
+	}
+
+	return scalar.NewBooleanScalar(result)
+}
+
+// maxStringScalar returns the lexicographic maximum of the non-null strings as a scalar, or nil if there is none.
+func maxStringScalar[A utils.StringArray, S scalar.Scalar](arr A, newScalar func(string) S) scalar.Scalar {
+	var maxValue string
+	found := false
+	utils.VisitValidRuns(arr, func(start, end int) {
+		for i := start; i < end; i++ {
+			if v := arr.Value(i); !found || v > maxValue {
+				maxValue = v
+				found = true
+			}
+		}
+	})
+
+	if !found {
+		return nil
 	}
 
-	return scl, nil
+	// The scalar constructor copies the string out of the array buffer
+	return newScalar(maxValue)
 }
 
-func maxArray[T utils.Numeric](arr utils.NumericArray[T]) T {
-	maxValue := arr.Value(0)
-	for i := 1; i < arr.Len(); i++ {
-		if arr.Value(i) > maxValue {
-			maxValue = arr.Value(i)
+func maxSlice[T utils.Numeric](values []T) T {
+	maxValue := values[0]
+	for _, v := range values[1:] {
+		if v > maxValue {
+			maxValue = v
 		}
 	}
 
@@ -0,0 +1,109 @@
+package array
+
+import (
+	"context"
+	"math"
+	"math/big"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/scalar"
+
+	"github.com/SHIMA0111/gleam/gleam/utils"
+	internalUtils "github.com/SHIMA0111/gleam/internal/utils"
+)
+
+// MeanState is the partial sum and count of the non-null values of an array.
+type MeanState struct {
+	Sum   SumState
+	Count int64
+}
+
+// Merge combines two partial means of the same input type.
+func (s MeanState) Merge(other MeanState) MeanState {
+	return MeanState{
+		Sum:   s.Sum.Merge(other.Sum),
+		Count: s.Count + other.Count,
+	}
+}
+
+// Float64 returns the mean, or NaN if there was no non-null value.
+func (s MeanState) Float64(policy utils.OverflowPolicy) (float64, error) {
+	if s.Count == 0 {
+		return math.NaN(), nil
+	}
+
+	sumVal, err := s.Sum.Float64(policy)
+	if err != nil {
+		return 0, err
+	}
+
+	return sumVal / float64(s.Count), nil
+}
+
+// Scalar returns the mean as a Float64 scalar, see Float64. The mean of decimals is instead exact,
+// rounded half to even to the scale of the input type and of the input type.
+// Either is null if there was no non-null value.
+func (s MeanState) Scalar(policy utils.OverflowPolicy) (scalar.Scalar, error) {
+	if s.Sum.kind != decimalSum {
+		if s.Count == 0 {
+			return scalar.MakeNullScalar(arrow.PrimitiveTypes.Float64), nil
+		}
+
+		mean, err := s.Float64(policy)
+		if err != nil {
+			return nil, err
+		}
+		return scalar.NewFloat64Scalar(mean), nil
+	}
+
+	dtype := s.Sum.decimalType
+	if s.Count == 0 {
+		return scalar.MakeNullScalar(dtype), nil
+	}
+
+	// The mean lies between the minimum and the maximum, so it always fits in the input precision
+	mean := new(big.Rat).SetFrac(s.Sum.decimal, new(big.Int).Mul(big.NewInt(s.Count), internalUtils.Pow10(dtype.GetScale())))
+	unscaled := internalUtils.RoundRat(mean, dtype.GetScale(), utils.RoundHalfEven)
+
+	return newDecimalScalar(unscaled, dtype.GetPrecision(), dtype.GetScale()), nil
+}
+
+// MeanStateOf sums and counts the non-null values of the array as a mergeable partial state.
+func MeanStateOf(ctx context.Context, arr arrow.Array) (MeanState, error) {
+	sumState, err := SumStateOf(ctx, arr)
+	if err != nil {
+		return MeanState{}, err
+	}
+
+	return MeanState{Sum: sumState, Count: int64(arr.Len() - arr.NullN())}, nil
+}
+
+// SkipNaNMeanStateOf is MeanStateOf that leaves NaN values out of both the sum and the count.
+func SkipNaNMeanStateOf(ctx context.Context, arr arrow.Array) (MeanState, error) {
+	sumState, nanCount, err := skipNaNSumState(ctx, arr, false)
+	if err != nil {
+		return MeanState{}, err
+	}
+
+	return MeanState{Sum: sumState, Count: int64(arr.Len()-arr.NullN()) - nanCount}, nil
+}
+
+// PreciseSkipNaNMeanStateOf is SkipNaNMeanStateOf with Kahan-Babuska compensated summation of float values.
+func PreciseSkipNaNMeanStateOf(ctx context.Context, arr arrow.Array) (MeanState, error) {
+	sumState, nanCount, err := skipNaNSumState(ctx, arr, true)
+	if err != nil {
+		return MeanState{}, err
+	}
+
+	return MeanState{Sum: sumState, Count: int64(arr.Len()-arr.NullN()) - nanCount}, nil
+}
+
+// PreciseMeanStateOf is MeanStateOf with Kahan-Babuska compensated summation of float values.
+func PreciseMeanStateOf(ctx context.Context, arr arrow.Array) (MeanState, error) {
+	sumState, err := PreciseSumStateOf(ctx, arr)
+	if err != nil {
+		return MeanState{}, err
+	}
+
+	return MeanState{Sum: sumState, Count: int64(arr.Len() - arr.NullN())}, nil
+}
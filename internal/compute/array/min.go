@@ -6,19 +6,51 @@ import (
 
 	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/decimal128"
+	"github.com/apache/arrow-go/v18/arrow/decimal256"
 	"github.com/apache/arrow-go/v18/arrow/memory"
 	"github.com/apache/arrow-go/v18/arrow/scalar"
 
 	"github.com/SHIMA0111/gleam/internal/utils"
 )
 
+// MinState is the partial minimum of an array. Value is nil while no non-null value has been seen,
+// so the partial states of all-null chunks can be merged as well.
+type MinState struct {
+	Value scalar.Scalar
+}
+
+// Merge combines two partial minimums of the same input type. A NaN partial result propagates.
+func (s MinState) Merge(other MinState) MinState {
+	if isNaNScalar(s.Value) {
+		return s
+	}
+	if isNaNScalar(other.Value) {
+		return other
+	}
+	if s.Value == nil || (other.Value != nil && compareScalars(other.Value, s.Value) < 0) {
+		return other
+	}
+
+	return s
+}
+
+// Scalar returns the minimum, or a null scalar of the given type if every value was null.
+func (s MinState) Scalar(dtype arrow.DataType) scalar.Scalar {
+	if s.Value == nil {
+		return scalar.MakeNullScalar(dtype)
+	}
+
+	return s.Value
+}
+
 func MinArray(ctx context.Context, arr arrow.Array, mem memory.Allocator) (arrow.Array, error) {
-	sumScl, err := Min(ctx, arr)
+	minScl, err := Min(ctx, arr)
 	if err != nil {
 		return nil, err
 	}
 
-	newArray, err := scalar.MakeArrayFromScalar(sumScl, 1, mem)
+	newArray, err := scalar.MakeArrayFromScalar(minScl, 1, mem)
 	if err != nil {
 		return nil, err
 	}
@@ -28,58 +60,233 @@ func MinArray(ctx context.Context, arr arrow.Array, mem memory.Allocator) (arrow
 
 func Min(ctx context.Context, arr arrow.Array) (scalar.Scalar, error) {
 	if arr.Len() == 0 {
-		return nil, fmt.Errorf("cannot find min value of empty Series")
+		return nil, fmt.Errorf("cannot find min value of empty array")
 	}
 
-	droppedArray, err := DropNullArray(ctx, arr)
+	state, err := MinStateOf(ctx, arr)
 	if err != nil {
 		return nil, err
 	}
 
+	return state.Scalar(arr.DataType()), nil
+}
+
+// MinStateOf finds the minimum of the non-null values of the array as a mergeable partial state.
+// Numeric and temporal values compare by value and strings lexicographically by their UTF-8 bytes.
+// Dictionary-encoded values compare by their decoded value, and the result is the dictionary element.
+// The minimum of booleans is true if every value is true. The minimum of float values containing NaN is NaN.
+func MinStateOf(ctx context.Context, arr arrow.Array) (MinState, error) {
+	return minStateOf(ctx, arr, false)
+}
+
+// SkipNaNMinStateOf is MinStateOf that leaves NaN values out like nulls.
+// The partial state of an array whose values are all NaN or null is empty.
+func SkipNaNMinStateOf(ctx context.Context, arr arrow.Array) (MinState, error) {
+	return minStateOf(ctx, arr, true)
+}
+
+func minStateOf(ctx context.Context, arr arrow.Array, skipNaN bool) (MinState, error) {
+	if err := ctx.Err(); err != nil {
+		return MinState{}, err
+	}
+
 	var scl scalar.Scalar
 	switch arr.DataType().ID() {
 	case arrow.INT8:
-		val := minArray[int8](droppedArray.(*array.Int8))
-		scl = scalar.NewInt8Scalar(val)
+		scl = minScalar[int8](arr, scalar.NewInt8Scalar)
 	case arrow.INT16:
-		val := minArray[int16](droppedArray.(*array.Int16))
-		scl = scalar.NewInt16Scalar(val)
+		scl = minScalar[int16](arr, scalar.NewInt16Scalar)
 	case arrow.INT32:
-		val := minArray[int32](droppedArray.(*array.Int32))
-		scl = scalar.NewInt32Scalar(val)
+		scl = minScalar[int32](arr, scalar.NewInt32Scalar)
 	case arrow.INT64:
-		val := minArray[int64](droppedArray.(*array.Int64))
-		scl = scalar.NewInt64Scalar(val)
+		scl = minScalar[int64](arr, scalar.NewInt64Scalar)
 	case arrow.UINT8:
-		val := minArray[uint8](droppedArray.(*array.Uint8))
-		scl = scalar.NewUint8Scalar(val)
+		scl = minScalar[uint8](arr, scalar.NewUint8Scalar)
 	case arrow.UINT16:
-		val := minArray[uint16](droppedArray.(*array.Uint16))
-		scl = scalar.NewUint16Scalar(val)
+		scl = minScalar[uint16](arr, scalar.NewUint16Scalar)
 	case arrow.UINT32:
-		val := minArray[uint32](droppedArray.(*array.Uint32))
-		scl = scalar.NewUint32Scalar(val)
+		scl = minScalar[uint32](arr, scalar.NewUint32Scalar)
 	case arrow.UINT64:
-		val := minArray[uint64](droppedArray.(*array.Uint64))
-		scl = scalar.NewUint64Scalar(val)
+		scl = minScalar[uint64](arr, scalar.NewUint64Scalar)
 	case arrow.FLOAT32:
-		val := minArray[float32](droppedArray.(*array.Float32))
-		scl = scalar.NewFloat32Scalar(val)
+		scl = minFloatScalar[float32](arr, scalar.NewFloat32Scalar, skipNaN)
 	case arrow.FLOAT64:
-		val := minArray[float64](droppedArray.(*array.Float64))
-		scl = scalar.NewFloat64Scalar(val)
+		scl = minFloatScalar[float64](arr, scalar.NewFloat64Scalar, skipNaN)
+	case arrow.BOOL:
+		scl = minBoolScalar(arr.(*array.Boolean))
+	case arrow.STRING:
+		scl = minStringScalar(arr.(*array.String), scalar.NewStringScalar)
+	case arrow.LARGE_STRING:
+		scl = minStringScalar(arr.(*array.LargeString), scalar.NewLargeStringScalar)
+	case arrow.DATE32:
+		scl = minScalar[arrow.Date32](arr, scalar.NewDate32Scalar)
+	case arrow.DATE64:
+		scl = minScalar[arrow.Date64](arr, scalar.NewDate64Scalar)
+	case arrow.TIMESTAMP:
+		scl = minScalar[arrow.Timestamp](arr, func(v arrow.Timestamp) *scalar.Timestamp {
+			return scalar.NewTimestampScalar(v, arr.DataType())
+		})
+	case arrow.TIME32:
+		scl = minScalar[arrow.Time32](arr, func(v arrow.Time32) *scalar.Time32 {
+			return scalar.NewTime32Scalar(v, arr.DataType())
+		})
+	case arrow.TIME64:
+		scl = minScalar[arrow.Time64](arr, func(v arrow.Time64) *scalar.Time64 {
+			return scalar.NewTime64Scalar(v, arr.DataType())
+		})
+	case arrow.DECIMAL128:
+		scl = minDecimalScalar[decimal128.Num](arr, func(v decimal128.Num) *scalar.Decimal128 {
+			return scalar.NewDecimal128Scalar(v, arr.DataType())
+		})
+	case arrow.DECIMAL256:
+		scl = minDecimalScalar[decimal256.Num](arr, func(v decimal256.Num) *scalar.Decimal256 {
+			return scalar.NewDecimal256Scalar(v, arr.DataType())
+		})
+	case arrow.DICTIONARY:
+		var err error
+		scl, err = dictionaryExtremum(arr.(*array.Dictionary), func(cmp int) bool { return cmp < 0 })
+		if err != nil {
+			return MinState{}, err
+		}
+	case arrow.DURATION:
+		scl = minScalar[arrow.Duration](arr, func(v arrow.Duration) *scalar.Duration {
+			return scalar.NewDurationScalar(v, arr.DataType())
+		})
 	default:
-		return nil, fmt.Errorf("unsupported data type: %s", arr.DataType())
+		return MinState{}, fmt.Errorf("unsupported data type: %s", arr.DataType())
+	}
+
+	return MinState{Value: scl}, nil
+}
+
+// minScalar returns the minimum of the non-null values as a scalar, or nil if there is none.
+// The values are read in place, run by run along the validity bitmap.
+func minScalar[T utils.Numeric, S scalar.Scalar](arr arrow.Array, newScalar func(T) S) scalar.Scalar {
+	values := arrow.GetValues[T](arr.Data(), 1)
+
+	var minValue T
+	found := false
+	utils.VisitValidRuns(arr, func(start, end int) {
+		runMin := minSlice(values[start:end])
+		if !found || runMin < minValue {
+			minValue = runMin
+			found = true
+		}
+	})
+
+	if !found {
+		return nil
+	}
+
+	return newScalar(minValue)
+}
+
+// minFloatScalar is minScalar for float arrays. A NaN value is the result unless skipNaN is set,
+// in which case NaN values are ignored like nulls.
+func minFloatScalar[T float32 | float64, S scalar.Scalar](arr arrow.Array, newScalar func(T) S, skipNaN bool) scalar.Scalar {
+	values := arrow.GetValues[T](arr.Data(), 1)
+
+	var minValue T
+	found := false
+	nan := false
+	utils.VisitValidRuns(arr, func(start, end int) {
+		if nan {
+			return
+		}
+		for _, v := range values[start:end] {
+			if v != v {
+				if skipNaN {
+					continue
+				}
+				minValue = v
+				nan = true
+				return
+			}
+			if !found || v < minValue {
+				minValue = v
+				found = true
+			}
+		}
+	})
+
+	if !nan && !found {
+		return nil
+	}
+
+	return newScalar(minValue)
+}
+
+// minDecimalScalar returns the minimum of the non-null decimal values as a scalar, or nil if there is none.
+func minDecimalScalar[T decimalNum[T], S scalar.Scalar](arr arrow.Array, newScalar func(T) S) scalar.Scalar {
+	values := arrow.GetValues[T](arr.Data(), 1)
+
+	var minValue T
+	found := false
+	utils.VisitValidRuns(arr, func(start, end int) {
+		for _, v := range values[start:end] {
+			if !found || v.Less(minValue) {
+				minValue = v
+				found = true
+			}
+		}
+	})
+
+	if !found {
+		return nil
+	}
+
+	return newScalar(minValue)
+}
+
+// minBoolScalar returns whether every non-null value is true, or nil if there is none.
+func minBoolScalar(arr *array.Boolean) scalar.Scalar {
+	found := false
+	result := true
+	utils.VisitValidRuns(arr, func(start, end int) {
+		found = true
+		for i := start; i < end; i++ {
+			if !result {
+				break
+			}
+			if !arr.Value(i) {
+				result = false
+			}
+		}
+	})
+
+	if !found {
+		return nil
+	}
+
+	return scalar.NewBooleanScalar(result)
+}
+
+// minStringScalar returns the lexicographic minimum of the non-null strings as a scalar, or nil if there is none.
+func minStringScalar[A utils.StringArray, S scalar.Scalar](arr A, newScalar func(string) S) scalar.Scalar {
+	var minValue string
+	found := false
+	utils.VisitValidRuns(arr, func(start, end int) {
+		for i := start; i < end; i++ {
+			if v := arr.Value(i); !found || v < minValue {
+				minValue = v
+				found = true
+			}
+		}
+	})
+
+	if !found {
+		return nil
 	}
 
-	return scl, nil
+	// The scalar constructor copies the string out of the array buffer
+	return newScalar(minValue)
 }
 
-func minArray[T utils.Numeric](arr utils.NumericArray[T]) T {
-	minValue := arr.Value(0)
-	for i := 1; i < arr.Len(); i++ {
-		if arr.Value(i) < minValue {
-			minValue = arr.Value(i)
+func minSlice[T utils.Numeric](values []T) T {
+	minValue := values[0]
+	for _, v := range values[1:] {
+		if v < minValue {
+			minValue = v
 		}
 	}
 
@@ -0,0 +1,47 @@
+package array
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/scalar"
+
+	"github.com/SHIMA0111/gleam/internal/utils"
+)
+
+// HasNaN reports whether any non-null value of the array is NaN. Arrays of non-float types never contain NaN.
+func HasNaN(arr arrow.Array) bool {
+	switch arr.DataType().ID() {
+	case arrow.FLOAT32:
+		return utils.HasNaN[float32](arr)
+	case arrow.FLOAT64:
+		return utils.HasNaN[float64](arr)
+	default:
+		return false
+	}
+}
+
+// RejectNaN wraps a partial state function so that it returns an error for an array containing NaN.
+func RejectNaN[P any](stateOf func(context.Context, arrow.Array) (P, error)) func(context.Context, arrow.Array) (P, error) {
+	return func(ctx context.Context, arr arrow.Array) (P, error) {
+		if HasNaN(arr) {
+			var zero P
+			return zero, fmt.Errorf("nan value detected in %s array", arr.DataType())
+		}
+
+		return stateOf(ctx, arr)
+	}
+}
+
+// isNaNScalar reports whether the scalar is a float NaN.
+func isNaNScalar(scl scalar.Scalar) bool {
+	switch v := scl.(type) {
+	case *scalar.Float32:
+		return v.Value != v.Value
+	case *scalar.Float64:
+		return v.Value != v.Value
+	default:
+		return false
+	}
+}
@@ -0,0 +1,222 @@
+package array
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/bitutil"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/arrow/scalar"
+
+	"github.com/SHIMA0111/gleam/gleam/utils"
+)
+
+// IsList reports whether the type is a List, LargeList or FixedSizeList type.
+func IsList(dtype arrow.DataType) bool {
+	switch dtype.ID() {
+	case arrow.LIST, arrow.LARGE_LIST, arrow.FIXED_SIZE_LIST:
+		return true
+	default:
+		return false
+	}
+}
+
+// ListLengths returns the number of elements of every list as an Int64 array. A null list has a null length.
+func ListLengths(ctx context.Context, arr array.ListLike, mem memory.Allocator) (arrow.Array, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	builder := array.NewInt64Builder(mem)
+	defer builder.Release()
+	builder.Reserve(arr.Len())
+
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			builder.AppendNull()
+			continue
+		}
+		start, end := arr.ValueOffsets(i)
+		builder.UnsafeAppend(end - start)
+	}
+
+	return builder.NewArray(), nil
+}
+
+// ListGet returns the element at index of every list, counting from the end of the list if index is negative.
+// The element is null if the list is null or too short.
+func ListGet(ctx context.Context, arr array.ListLike, index int, mem memory.Allocator) (arrow.Array, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	builder := array.NewInt64Builder(mem)
+	defer builder.Release()
+	builder.Reserve(arr.Len())
+
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			builder.AppendNull()
+			continue
+		}
+
+		start, end := arr.ValueOffsets(i)
+		position := int64(index)
+		if position < 0 {
+			position += end - start
+		}
+		if position < 0 || position >= end-start {
+			builder.AppendNull()
+			continue
+		}
+		builder.UnsafeAppend(start + position)
+	}
+
+	positions := builder.NewArray()
+	defer positions.Release()
+
+	return Take(ctx, arr.ListValues(), positions)
+}
+
+// ListContains reports for every list whether one of its elements equals the value, which has the element type.
+// A null list gives null and null elements never match.
+func ListContains(ctx context.Context, arr array.ListLike, value scalar.Scalar, mem memory.Allocator) (arrow.Array, error) {
+	start, _ := listValueRange(arr)
+	values := ListValues(arr)
+	defer values.Release()
+
+	matches, err := Comparison(ctx, values, utils.Equal, value)
+	if err != nil {
+		return nil, err
+	}
+	defer matches.Release()
+
+	matched := matches.(*array.Boolean)
+
+	builder := array.NewBooleanBuilder(mem)
+	defer builder.Release()
+	builder.Reserve(arr.Len())
+
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			builder.AppendNull()
+			continue
+		}
+
+		listStart, listEnd := arr.ValueOffsets(i)
+		found := false
+		for j := listStart - start; j < listEnd-start && !found; j++ {
+			found = matched.IsValid(int(j)) && matched.Value(int(j))
+		}
+		builder.UnsafeAppend(found)
+	}
+
+	return builder.NewArray(), nil
+}
+
+// ListExplode returns the elements of all lists in order, as one array of the element type.
+// A null or empty list becomes one null element, so every list keeps at least one row.
+// If no list is null or empty, the result is a zero-copy slice of the list values.
+func ListExplode(ctx context.Context, arr array.ListLike, mem memory.Allocator) (arrow.Array, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if arr.NullN() == 0 && !hasEmptyList(arr) {
+		return ListValues(arr), nil
+	}
+
+	start, end := listValueRange(arr)
+	builder := array.NewInt64Builder(mem)
+	defer builder.Release()
+	builder.Reserve(int(end-start) + arr.Len())
+
+	for i := 0; i < arr.Len(); i++ {
+		listStart, listEnd := arr.ValueOffsets(i)
+		if arr.IsNull(i) || listStart == listEnd {
+			builder.AppendNull()
+			continue
+		}
+		for j := listStart; j < listEnd; j++ {
+			builder.Append(j)
+		}
+	}
+
+	positions := builder.NewArray()
+	defer positions.Release()
+
+	return Take(ctx, arr.ListValues(), positions)
+}
+
+// StructField returns the child array of the named field. The child shares the buffers of the struct array;
+// if the struct has null elements, a new validity bitmap makes the field null there as well.
+func StructField(arr *array.Struct, name string, mem memory.Allocator) (arrow.Array, error) {
+	index, ok := arr.DataType().(*arrow.StructType).FieldIdx(name)
+	if !ok {
+		return nil, fmt.Errorf("struct has no field %q", name)
+	}
+
+	field := arr.Field(index)
+	if arr.NullN() == 0 || field.DataType().ID() == arrow.NULL {
+		field.Retain()
+		return field, nil
+	}
+
+	// The bitmap is aligned with the offset of the child, so only the validity buffer is replaced
+	data := field.Data()
+	validity := memory.NewResizableBuffer(mem)
+	validity.Resize(int(bitutil.BytesForBits(int64(data.Offset() + data.Len()))))
+	defer validity.Release()
+	memory.Set(validity.Bytes(), 0)
+
+	nullCount := 0
+	for i := 0; i < field.Len(); i++ {
+		if arr.IsValid(i) && field.IsValid(i) {
+			bitutil.SetBit(validity.Bytes(), data.Offset()+i)
+		} else {
+			nullCount++
+		}
+	}
+
+	buffers := append([]*memory.Buffer{validity}, data.Buffers()[1:]...)
+	var newData *array.Data
+	if dict := data.Dictionary(); dict != nil {
+		newData = array.NewDataWithDictionary(data.DataType(), data.Len(), buffers, nullCount, data.Offset(), dict.(*array.Data))
+	} else {
+		newData = array.NewData(data.DataType(), data.Len(), buffers, data.Children(), nullCount, data.Offset())
+	}
+	defer newData.Release()
+
+	return array.MakeFromData(newData), nil
+}
+
+// ListValues returns the list values that the lists of the array cover, as a zero-copy slice.
+func ListValues(arr array.ListLike) arrow.Array {
+	start, end := listValueRange(arr)
+
+	return array.NewSlice(arr.ListValues(), start, end)
+}
+
+// listValueRange returns the range of the list values that the lists of the array cover.
+func listValueRange(arr array.ListLike) (start, end int64) {
+	if arr.Len() == 0 {
+		return 0, 0
+	}
+
+	start, _ = arr.ValueOffsets(0)
+	_, end = arr.ValueOffsets(arr.Len() - 1)
+
+	return start, end
+}
+
+func hasEmptyList(arr array.ListLike) bool {
+	for i := 0; i < arr.Len(); i++ {
+		if start, end := arr.ValueOffsets(i); start == end {
+			return true
+		}
+	}
+
+	return false
+}
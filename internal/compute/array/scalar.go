@@ -0,0 +1,72 @@
+package array
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+
+	"github.com/apache/arrow-go/v18/arrow/scalar"
+)
+
+// compareScalars compares two valid scalars of the same type, returning -1, 0 or +1 like cmp.Compare.
+func compareScalars(a, b scalar.Scalar) int {
+	switch av := a.(type) {
+	case *scalar.Int8:
+		return cmp.Compare(av.Value, b.(*scalar.Int8).Value)
+	case *scalar.Int16:
+		return cmp.Compare(av.Value, b.(*scalar.Int16).Value)
+	case *scalar.Int32:
+		return cmp.Compare(av.Value, b.(*scalar.Int32).Value)
+	case *scalar.Int64:
+		return cmp.Compare(av.Value, b.(*scalar.Int64).Value)
+	case *scalar.Uint8:
+		return cmp.Compare(av.Value, b.(*scalar.Uint8).Value)
+	case *scalar.Uint16:
+		return cmp.Compare(av.Value, b.(*scalar.Uint16).Value)
+	case *scalar.Uint32:
+		return cmp.Compare(av.Value, b.(*scalar.Uint32).Value)
+	case *scalar.Uint64:
+		return cmp.Compare(av.Value, b.(*scalar.Uint64).Value)
+	case *scalar.Float32:
+		return cmp.Compare(av.Value, b.(*scalar.Float32).Value)
+	case *scalar.Float64:
+		return cmp.Compare(av.Value, b.(*scalar.Float64).Value)
+	case *scalar.Boolean:
+		return cmp.Compare(boolRank(av.Value), boolRank(b.(*scalar.Boolean).Value))
+	case *scalar.Date32:
+		return cmp.Compare(av.Value, b.(*scalar.Date32).Value)
+	case *scalar.Date64:
+		return cmp.Compare(av.Value, b.(*scalar.Date64).Value)
+	case *scalar.Timestamp:
+		return cmp.Compare(av.Value, b.(*scalar.Timestamp).Value)
+	case *scalar.Time32:
+		return cmp.Compare(av.Value, b.(*scalar.Time32).Value)
+	case *scalar.Time64:
+		return cmp.Compare(av.Value, b.(*scalar.Time64).Value)
+	case *scalar.Duration:
+		return cmp.Compare(av.Value, b.(*scalar.Duration).Value)
+	case *scalar.Decimal128:
+		return av.Value.Cmp(b.(*scalar.Decimal128).Value)
+	case *scalar.Decimal256:
+		return av.Value.Cmp(b.(*scalar.Decimal256).Value)
+	case *scalar.Dictionary:
+		// Dictionary elements compare by their decoded values; decoding a valid element cannot fail
+		aValue, _ := av.GetEncodedValue()
+		bValue, _ := b.(*scalar.Dictionary).GetEncodedValue()
+		return compareScalars(aValue, bValue)
+	case scalar.BinaryScalar:
+		// Strings compare lexicographically by their UTF-8 bytes
+		return bytes.Compare(av.Data(), b.(scalar.BinaryScalar).Data())
+	default:
+		panic(fmt.Sprintf("cannot compare scalars of type %s", a.DataType()))
+	}
+}
+
+// boolRank orders false before true.
+func boolRank(v bool) int {
+	if v {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,206 @@
+package array
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+
+	"github.com/SHIMA0111/gleam/gleam/utils"
+	"github.com/SHIMA0111/gleam/internal/compute/parallel"
+)
+
+// SortOptions configures ArgSort and Sort.
+type SortOptions struct {
+	Descending    bool
+	NullPlacement utils.NullPlacement
+	NaNPlacement  utils.NaNPlacement
+	// Stable keeps equal elements, and the nulls and NaN values, in their input order.
+	Stable bool
+	// ChunkSize is the length of the runs sorted concurrently, or 0 to sort in one piece.
+	ChunkSize int
+}
+
+// ArgSort returns the Int64 positions of the elements of the array in sorted order,
+// which Take gathers into the sorted array. Returns an error if the type of the array has no order.
+func ArgSort(ctx context.Context, arr arrow.Array, opts SortOptions, mem memory.Allocator) (arrow.Array, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	compare, isNaN, err := elementCompare(ctx, arr)
+	if err != nil {
+		return nil, err
+	}
+
+	var nulls, nans []int64
+	values := make([]int64, 0, arr.Len()-arr.NullN())
+	for i := 0; i < arr.Len(); i++ {
+		switch {
+		case arr.IsNull(i):
+			nulls = append(nulls, int64(i))
+		case isNaN != nil && isNaN(i):
+			nans = append(nans, int64(i))
+		default:
+			values = append(values, int64(i))
+		}
+	}
+
+	order := func(a, b int64) int {
+		if opts.Descending {
+			return compare(int(b), int(a))
+		}
+		return compare(int(a), int(b))
+	}
+	if err := parallel.SortFunc(ctx, values, opts.ChunkSize, order, opts.Stable); err != nil {
+		return nil, err
+	}
+
+	// NaN values are placed inside the nulls, so the nulls are outermost
+	sorted := values
+	if opts.NaNPlacement == utils.NaNFirst {
+		sorted = append(nans, sorted...)
+	} else {
+		sorted = append(sorted, nans...)
+	}
+	if opts.NullPlacement == utils.NullsFirst {
+		sorted = append(nulls, sorted...)
+	} else {
+		sorted = append(sorted, nulls...)
+	}
+
+	builder := array.NewInt64Builder(mem)
+	defer builder.Release()
+	builder.AppendValues(sorted, nil)
+
+	return builder.NewArray(), nil
+}
+
+// Sort returns the elements of the array in sorted order, see ArgSort.
+func Sort(ctx context.Context, arr arrow.Array, opts SortOptions, mem memory.Allocator) (arrow.Array, error) {
+	indices, err := ArgSort(ctx, arr, opts, mem)
+	if err != nil {
+		return nil, err
+	}
+	defer indices.Release()
+
+	return Take(ctx, arr, indices)
+}
+
+// elementCompare returns a function comparing the valid elements i and j of the array, and for float arrays
+// a function telling whether the element i is NaN, which compare does not order.
+func elementCompare(ctx context.Context, arr arrow.Array) (compare func(i, j int) int, isNaN func(i int) bool, err error) {
+	switch a := arr.(type) {
+	case *array.Null:
+		// Every element is null, so there is nothing to compare
+		return func(i, j int) int { return 0 }, nil, nil
+	case *array.Int8:
+		return valuesCompare(a.Int8Values()), nil, nil
+	case *array.Int16:
+		return valuesCompare(a.Int16Values()), nil, nil
+	case *array.Int32:
+		return valuesCompare(a.Int32Values()), nil, nil
+	case *array.Int64:
+		return valuesCompare(a.Int64Values()), nil, nil
+	case *array.Uint8:
+		return valuesCompare(a.Uint8Values()), nil, nil
+	case *array.Uint16:
+		return valuesCompare(a.Uint16Values()), nil, nil
+	case *array.Uint32:
+		return valuesCompare(a.Uint32Values()), nil, nil
+	case *array.Uint64:
+		return valuesCompare(a.Uint64Values()), nil, nil
+	case *array.Float32:
+		values := a.Float32Values()
+		return valuesCompare(values), func(i int) bool { return math.IsNaN(float64(values[i])) }, nil
+	case *array.Float64:
+		values := a.Float64Values()
+		return valuesCompare(values), func(i int) bool { return math.IsNaN(values[i]) }, nil
+	case *array.Date32:
+		return valuesCompare(a.Date32Values()), nil, nil
+	case *array.Date64:
+		return valuesCompare(a.Date64Values()), nil, nil
+	case *array.Time32:
+		return valuesCompare(a.Time32Values()), nil, nil
+	case *array.Time64:
+		return valuesCompare(a.Time64Values()), nil, nil
+	case *array.Timestamp:
+		return valuesCompare(a.TimestampValues()), nil, nil
+	case *array.Duration:
+		return valuesCompare(a.DurationValues()), nil, nil
+	case *array.Boolean:
+		return func(i, j int) int { return compareBool(a.Value(i), a.Value(j)) }, nil, nil
+	case *array.String:
+		return func(i, j int) int { return cmp.Compare(a.Value(i), a.Value(j)) }, nil, nil
+	case *array.LargeString:
+		return func(i, j int) int { return cmp.Compare(a.Value(i), a.Value(j)) }, nil, nil
+	case *array.Binary:
+		return func(i, j int) int { return bytes.Compare(a.Value(i), a.Value(j)) }, nil, nil
+	case *array.LargeBinary:
+		return func(i, j int) int { return bytes.Compare(a.Value(i), a.Value(j)) }, nil, nil
+	case *array.FixedSizeBinary:
+		return func(i, j int) int { return bytes.Compare(a.Value(i), a.Value(j)) }, nil, nil
+	case *array.Decimal128:
+		// The elements share the scale of the type, so their unscaled values compare as the decimals do
+		return func(i, j int) int { return a.Value(i).Cmp(a.Value(j)) }, nil, nil
+	case *array.Decimal256:
+		return func(i, j int) int { return a.Value(i).Cmp(a.Value(j)) }, nil, nil
+	case *array.Dictionary:
+		ranks, err := dictionaryRanks(ctx, a.Dictionary())
+		if err != nil {
+			return nil, nil, err
+		}
+		return func(i, j int) int { return cmp.Compare(ranks[a.GetValueIndex(i)], ranks[a.GetValueIndex(j)]) }, nil, nil
+	default:
+		return nil, nil, fmt.Errorf("cannot sort %s array", arr.DataType())
+	}
+}
+
+// dictionaryRanks returns the rank of every dictionary entry in the order of the values, equal values sharing
+// a rank, so that the elements of a dictionary array sort by their values instead of their codes.
+func dictionaryRanks(ctx context.Context, dictionary arrow.Array) ([]int, error) {
+	compare, _, err := elementCompare(ctx, dictionary)
+	if err != nil {
+		return nil, err
+	}
+
+	order := make([]int, dictionary.Len())
+	for i := range order {
+		order[i] = i
+	}
+	if err := parallel.SortFunc(ctx, order, 0, compare, false); err != nil {
+		return nil, err
+	}
+
+	ranks := make([]int, dictionary.Len())
+	for k := 1; k < len(order); k++ {
+		ranks[order[k]] = ranks[order[k-1]]
+		if compare(order[k-1], order[k]) != 0 {
+			ranks[order[k]]++
+		}
+	}
+
+	return ranks, nil
+}
+
+func valuesCompare[T cmp.Ordered](values []T) func(i, j int) int {
+	return func(i, j int) int {
+		return cmp.Compare(values[i], values[j])
+	}
+}
+
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	default:
+		return -1
+	}
+}
@@ -3,26 +3,182 @@ package array
 import (
 	"context"
 	"fmt"
+	"math"
+	"math/big"
 
 	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
-	"github.com/apache/arrow-go/v18/arrow/math"
+	"github.com/apache/arrow-go/v18/arrow/decimal128"
+	"github.com/apache/arrow-go/v18/arrow/decimal256"
+	arrayMath "github.com/apache/arrow-go/v18/arrow/math"
 	"github.com/apache/arrow-go/v18/arrow/memory"
 	"github.com/apache/arrow-go/v18/arrow/scalar"
 
-	"github.com/SHIMA0111/gleam/internal/utils"
+	"github.com/SHIMA0111/gleam/gleam/utils"
+	internalUtils "github.com/SHIMA0111/gleam/internal/utils"
 )
 
 const SumThreshold = 150_000
 
-func SumArray(ctx context.Context, arr arrow.Array, mem memory.Allocator) (arrow.Array, error) {
-	f64Sum, err := Sum(ctx, arr)
+// WideSumType is the result type of an integer sum that does not fit in 64 bits.
+var WideSumType = &arrow.Decimal128Type{Precision: 38, Scale: 0}
+
+type sumKind int
+
+const (
+	signedSum sumKind = iota
+	unsignedSum
+	floatSum
+	decimalSum
+)
+
+// SumState is the partial sum of a numeric array.
+// Integer inputs are kept exactly as a 128-bit integer so the partial sums of chunks can be merged
+// without losing precision; they are narrowed to the result type only once, by Scalar.
+// Float inputs keep a compensation term, so merging partial sums does not add rounding errors either.
+// Decimal inputs are kept as an unbounded unscaled integer along with the input type.
+type SumState struct {
+	kind        sumKind
+	exact       decimal128.Num
+	float       internalUtils.KahanSum
+	decimal     *big.Int
+	decimalType arrow.DecimalType
+}
+
+// Merge combines two partial sums of the same input type.
+func (s SumState) Merge(other SumState) SumState {
+	if s.kind == decimalSum {
+		return SumState{
+			kind:        decimalSum,
+			decimal:     new(big.Int).Add(s.decimal, other.decimal),
+			decimalType: s.decimalType,
+		}
+	}
+
+	return SumState{
+		kind:  s.kind,
+		exact: s.exact.Add(other.exact),
+		float: s.float.Merge(other.float),
+	}
+}
+
+// Float64 returns the sum converted to float64, e.g. for the mean calculation.
+// An integer sum outside the 64-bit range is handled by the overflow policy first.
+func (s SumState) Float64(policy utils.OverflowPolicy) (float64, error) {
+	switch s.kind {
+	case floatSum:
+		return s.float.Value(), nil
+	case decimalSum:
+		f, _ := new(big.Rat).SetFrac(s.decimal, internalUtils.Pow10(s.decimalType.GetScale())).Float64()
+		return f, nil
+	}
+
+	scl, err := s.Scalar(policy)
+	if err != nil {
+		return 0, err
+	}
+
+	switch v := scl.(type) {
+	case *scalar.Int64:
+		return float64(v.Value), nil
+	case *scalar.Uint64:
+		return float64(v.Value), nil
+	default:
+		return s.exact.ToFloat64(0), nil
+	}
+}
+
+// Scalar returns the sum as an arrow scalar.
+// Signed integers produce Int64, unsigned integers produce Uint64 and floats produce Float64.
+// An integer sum outside the 64-bit range is handled by the overflow policy.
+// Decimals produce a decimal of the input width with the maximum precision and the input scale;
+// see decimalScalar for a sum beyond that precision.
+func (s SumState) Scalar(policy utils.OverflowPolicy) (scalar.Scalar, error) {
+	switch s.kind {
+	case decimalSum:
+		return s.decimalScalar(policy)
+	case signedSum:
+		if s.exact.HighBits() == int64(s.exact.LowBits())>>63 {
+			return scalar.NewInt64Scalar(int64(s.exact.LowBits())), nil
+		}
+
+		switch policy {
+		case utils.OverflowError:
+			return nil, fmt.Errorf("sum overflows int64: %s", s.exact.ToString(0))
+		case utils.OverflowWrap:
+			return scalar.NewInt64Scalar(int64(s.exact.LowBits())), nil
+		case utils.OverflowSaturate:
+			if s.exact.Sign() < 0 {
+				return scalar.NewInt64Scalar(math.MinInt64), nil
+			}
+			return scalar.NewInt64Scalar(math.MaxInt64), nil
+		}
+	case unsignedSum:
+		if s.exact.HighBits() == 0 {
+			return scalar.NewUint64Scalar(s.exact.LowBits()), nil
+		}
+
+		switch policy {
+		case utils.OverflowError:
+			return nil, fmt.Errorf("sum overflows uint64: %s", s.exact.ToString(0))
+		case utils.OverflowWrap:
+			return scalar.NewUint64Scalar(s.exact.LowBits()), nil
+		case utils.OverflowSaturate:
+			return scalar.NewUint64Scalar(math.MaxUint64), nil
+		}
+	case floatSum:
+		// Float sums follow IEEE 754: NaN values propagate and large values overflow to infinity
+		return scalar.NewFloat64Scalar(s.float.Value()), nil
+	}
+
+	if policy != utils.OverflowPromoteToDecimal {
+		return nil, fmt.Errorf("unknown overflow policy: %s", policy)
+	}
+
+	return scalar.NewDecimal128Scalar(s.exact, WideSumType), nil
+}
+
+// decimalScalar returns the decimal sum as a Decimal128 of precision 38 for Decimal128 input,
+// or as a Decimal256 of precision 76 for Decimal256 input.
+// A Decimal128 sum beyond 38 digits is promoted to Decimal256 by utils.OverflowPromoteToDecimal.
+// A sum beyond the precision of the result type is clamped by utils.OverflowSaturate and an error otherwise;
+// utils.OverflowWrap is not supported because decimals have no two's complement width to wrap around.
+func (s SumState) decimalScalar(policy utils.OverflowPolicy) (scalar.Scalar, error) {
+	scale := s.decimalType.GetScale()
+	wide := s.decimalType.ID() == arrow.DECIMAL256 || policy == utils.OverflowPromoteToDecimal
+
+	precision := int32(decimal128.MaxPrecision)
+	if internalUtils.FitsInPrecision(s.decimal, precision) && s.decimalType.ID() == arrow.DECIMAL128 {
+		return newDecimalScalar(s.decimal, precision, scale), nil
+	}
+	if wide {
+		precision = decimal256.MaxPrecision
+		if internalUtils.FitsInPrecision(s.decimal, precision) {
+			return newDecimalScalar(s.decimal, precision, scale), nil
+		}
+	}
+
+	switch policy {
+	case utils.OverflowSaturate:
+		limit := new(big.Int).Sub(internalUtils.Pow10(precision), big.NewInt(1))
+		if s.decimal.Sign() < 0 {
+			limit.Neg(limit)
+		}
+		return newDecimalScalar(limit, precision, scale), nil
+	case utils.OverflowWrap:
+		return nil, fmt.Errorf("overflow policy %s is not supported for decimal sums", policy)
+	default:
+		return nil, fmt.Errorf("sum overflows %d decimal digits: %s", precision, decimalString(s.decimal, scale))
+	}
+}
+
+func SumArray(ctx context.Context, arr arrow.Array, policy utils.OverflowPolicy, mem memory.Allocator) (arrow.Array, error) {
+	sumScl, err := Sum(ctx, arr, policy)
 	if err != nil {
 		return nil, err
 	}
 
-	scl := scalar.NewFloat64Scalar(f64Sum)
-	resArr, err := scalar.MakeArrayFromScalar(scl, 1, mem)
+	resArr, err := scalar.MakeArrayFromScalar(sumScl, 1, mem)
 	if err != nil {
 		return nil, err
 	}
@@ -30,79 +186,208 @@ func SumArray(ctx context.Context, arr arrow.Array, mem memory.Allocator) (arrow
 	return resArr, nil
 }
 
-func Sum(ctx context.Context, arr arrow.Array) (float64, error) {
+// Sum returns the sum of the array as a scalar. See SumState.Scalar for the result type.
+func Sum(ctx context.Context, arr arrow.Array, policy utils.OverflowPolicy) (scalar.Scalar, error) {
+	state, err := SumStateOf(ctx, arr)
+	if err != nil {
+		return nil, err
+	}
+
+	return state.Scalar(policy)
+}
+
+// SumStateOf sums the non-null values of the array into a mergeable partial sum.
+func SumStateOf(ctx context.Context, arr arrow.Array) (SumState, error) {
+	if err := ctx.Err(); err != nil {
+		return SumState{}, err
+	}
+
+	// Decimal arrays are always summed along the validity bitmap; there is no faster kernel for them
+	switch arr.DataType().ID() {
+	case arrow.DECIMAL128:
+		return decimalState(internalUtils.SumDecimal128Valid(arr), arr.DataType()), nil
+	case arrow.DECIMAL256:
+		return decimalState(internalUtils.SumDecimal256Valid(arr), arr.DataType()), nil
+	}
+
+	// Nullable arrays are summed by walking the validity bitmap instead of summing a filtered copy
+	if arr.NullN() > 0 {
+		return sumStateValid(arr)
+	}
+
 	switch arr.DataType().ID() {
 	case arrow.INT8:
 		if arr.Len() < SumThreshold {
-			res := utils.SumInt8Array(arr.(*array.Int8))
-			return utils.CheckOverflowAndConvertToFloat64(res)
+			return signedState(internalUtils.SumInt8Array(arr.(*array.Int8))), nil
 		} else {
-			return utils.CastSumInt(ctx, arr)
+			res, err := internalUtils.CastSumInt(ctx, arr)
+			return signedState(res), err
 		}
 	case arrow.INT16:
 		if arr.Len() < SumThreshold {
-			res := utils.SumInt16Array(arr.(*array.Int16))
-			return utils.CheckOverflowAndConvertToFloat64(res)
+			return signedState(internalUtils.SumInt16Array(arr.(*array.Int16))), nil
 		} else {
-			return utils.CastSumInt(ctx, arr)
+			res, err := internalUtils.CastSumInt(ctx, arr)
+			return signedState(res), err
 		}
 	case arrow.INT32:
 		if arr.Len() < SumThreshold {
-			res := utils.SumInt32Array(arr.(*array.Int32))
-			return utils.CheckOverflowAndConvertToFloat64(res)
+			return signedState(internalUtils.SumInt32Array(arr.(*array.Int32))), nil
 		} else {
-			return utils.CastSumInt(ctx, arr)
+			res, err := internalUtils.CastSumInt(ctx, arr)
+			return signedState(res), err
 		}
 	case arrow.INT64:
 		i64Array, ok := arr.(*array.Int64)
 		if !ok {
-			return 0, fmt.Errorf("failed to cast the array to Int64 from %s", arr.DataType())
+			return SumState{}, fmt.Errorf("failed to cast the array to Int64 from %s", arr.DataType())
 		}
 
-		return utils.CheckOverflowAndConvertToFloat64[int64](math.Int64.Sum(i64Array))
+		// The int64 sum can overflow, so the assembly-optimized arrow sum cannot be used here.
+		return SumState{kind: signedSum, exact: internalUtils.SumSigned(i64Array.Int64Values())}, nil
 	case arrow.UINT8:
 		if arr.Len() < SumThreshold {
-			res := utils.SumUInt8Array(arr.(*array.Uint8))
-			return utils.CheckOverflowAndConvertToFloat64(res)
+			return unsignedState(internalUtils.SumUInt8Array(arr.(*array.Uint8))), nil
 		} else {
-			return utils.CastSumUInt(ctx, arr)
+			res, err := internalUtils.CastSumUInt(ctx, arr)
+			return unsignedState(res), err
 		}
 	case arrow.UINT16:
 		if arr.Len() < SumThreshold {
-			res := utils.SumUInt16Array(arr.(*array.Uint16))
-			return utils.CheckOverflowAndConvertToFloat64(res)
+			return unsignedState(internalUtils.SumUInt16Array(arr.(*array.Uint16))), nil
 		} else {
-			return utils.CastSumUInt(ctx, arr)
+			res, err := internalUtils.CastSumUInt(ctx, arr)
+			return unsignedState(res), err
 		}
 	case arrow.UINT32:
 		if arr.Len() < SumThreshold {
-			res := utils.SumUInt32Array(arr.(*array.Uint32))
-			return utils.CheckOverflowAndConvertToFloat64(res)
+			return unsignedState(internalUtils.SumUInt32Array(arr.(*array.Uint32))), nil
 		} else {
-			return utils.CastSumUInt(ctx, arr)
+			res, err := internalUtils.CastSumUInt(ctx, arr)
+			return unsignedState(res), err
 		}
 	case arrow.UINT64:
 		u64Array, ok := arr.(*array.Uint64)
 		if !ok {
-			return 0, fmt.Errorf("failed to cast the array to Uint64 from %s", arr.DataType())
+			return SumState{}, fmt.Errorf("failed to cast the array to Uint64 from %s", arr.DataType())
 		}
 
-		return utils.CheckOverflowAndConvertToFloat64(math.Uint64.Sum(u64Array))
+		return SumState{kind: unsignedSum, exact: internalUtils.SumUnsigned(u64Array.Uint64Values())}, nil
 	case arrow.FLOAT32:
 		if arr.Len() < SumThreshold {
-			res := utils.SumFloat32Array(arr.(*array.Float32))
-			return utils.CheckOverflowAndConvertToFloat64(res)
+			return floatState(internalUtils.SumFloat32Array(arr.(*array.Float32))), nil
 		} else {
-			return utils.CastSumFloat(ctx, arr)
+			res, err := internalUtils.CastSumFloat(ctx, arr)
+			return floatState(res), err
 		}
 	case arrow.FLOAT64:
 		f64Array, ok := arr.(*array.Float64)
 		if !ok {
-			return 0, fmt.Errorf("failed to cast the array to Float64 from %s", arr.DataType())
+			return SumState{}, fmt.Errorf("failed to cast the array to Float64 from %s", arr.DataType())
 		}
 
-		return utils.CheckOverflowAndConvertToFloat64(math.Float64.Sum(f64Array))
+		return floatState(arrayMath.Float64.Sum(f64Array)), nil
+	default:
+		return SumState{}, fmt.Errorf("sum is not supported for %s", arr.DataType())
+	}
+}
+
+// PreciseSumStateOf is SumStateOf with Kahan-Babuska compensated summation of float values.
+// Integer values are summed exactly like SumStateOf.
+func PreciseSumStateOf(ctx context.Context, arr arrow.Array) (SumState, error) {
+	if err := ctx.Err(); err != nil {
+		return SumState{}, err
+	}
+
+	switch arr.DataType().ID() {
+	case arrow.FLOAT32:
+		return SumState{kind: floatSum, float: internalUtils.SumFloatPrecise[float32](arr)}, nil
+	case arrow.FLOAT64:
+		return SumState{kind: floatSum, float: internalUtils.SumFloatPrecise[float64](arr)}, nil
 	default:
-		return 0, fmt.Errorf("sum is not supported for %s", arr.DataType())
+		return SumStateOf(ctx, arr)
 	}
 }
+
+// SkipNaNSumStateOf is SumStateOf that leaves NaN values out of float sums like nulls.
+func SkipNaNSumStateOf(ctx context.Context, arr arrow.Array) (SumState, error) {
+	state, _, err := skipNaNSumState(ctx, arr, false)
+	return state, err
+}
+
+// PreciseSkipNaNSumStateOf is PreciseSumStateOf that leaves NaN values out of float sums like nulls.
+func PreciseSkipNaNSumStateOf(ctx context.Context, arr arrow.Array) (SumState, error) {
+	state, _, err := skipNaNSumState(ctx, arr, true)
+	return state, err
+}
+
+// skipNaNSumState returns the NaN-skipping partial sum, compensated if precise is set,
+// and the number of NaN values that were skipped.
+func skipNaNSumState(ctx context.Context, arr arrow.Array, precise bool) (SumState, int64, error) {
+	if err := ctx.Err(); err != nil {
+		return SumState{}, 0, err
+	}
+
+	switch arr.DataType().ID() {
+	case arrow.FLOAT32:
+		if precise {
+			sum, nanCount := internalUtils.SumFloatPreciseSkipNaN[float32](arr)
+			return SumState{kind: floatSum, float: sum}, nanCount, nil
+		}
+		sum, nanCount := internalUtils.SumFloatSkipNaN[float32](arr)
+		return floatState(sum), nanCount, nil
+	case arrow.FLOAT64:
+		if precise {
+			sum, nanCount := internalUtils.SumFloatPreciseSkipNaN[float64](arr)
+			return SumState{kind: floatSum, float: sum}, nanCount, nil
+		}
+		sum, nanCount := internalUtils.SumFloatSkipNaN[float64](arr)
+		return floatState(sum), nanCount, nil
+	default:
+		state, err := SumStateOf(ctx, arr)
+		return state, 0, err
+	}
+}
+
+func sumStateValid(arr arrow.Array) (SumState, error) {
+	switch arr.DataType().ID() {
+	case arrow.INT8:
+		return SumState{kind: signedSum, exact: internalUtils.SumSignedValid[int8](arr)}, nil
+	case arrow.INT16:
+		return SumState{kind: signedSum, exact: internalUtils.SumSignedValid[int16](arr)}, nil
+	case arrow.INT32:
+		return SumState{kind: signedSum, exact: internalUtils.SumSignedValid[int32](arr)}, nil
+	case arrow.INT64:
+		return SumState{kind: signedSum, exact: internalUtils.SumSignedValid[int64](arr)}, nil
+	case arrow.UINT8:
+		return SumState{kind: unsignedSum, exact: internalUtils.SumUnsignedValid[uint8](arr)}, nil
+	case arrow.UINT16:
+		return SumState{kind: unsignedSum, exact: internalUtils.SumUnsignedValid[uint16](arr)}, nil
+	case arrow.UINT32:
+		return SumState{kind: unsignedSum, exact: internalUtils.SumUnsignedValid[uint32](arr)}, nil
+	case arrow.UINT64:
+		return SumState{kind: unsignedSum, exact: internalUtils.SumUnsignedValid[uint64](arr)}, nil
+	case arrow.FLOAT32:
+		return floatState(internalUtils.SumFloatValid[float32](arr)), nil
+	case arrow.FLOAT64:
+		return floatState(internalUtils.SumFloatValid[float64](arr)), nil
+	default:
+		return SumState{}, fmt.Errorf("sum is not supported for %s", arr.DataType())
+	}
+}
+
+func signedState(v int64) SumState {
+	return SumState{kind: signedSum, exact: decimal128.FromI64(v)}
+}
+
+func unsignedState(v uint64) SumState {
+	return SumState{kind: unsignedSum, exact: decimal128.FromU64(v)}
+}
+
+func floatState(v float64) SumState {
+	return SumState{kind: floatSum, float: internalUtils.KahanSum{Sum: v}}
+}
+
+func decimalState(v *big.Int, dtype arrow.DataType) SumState {
+	return SumState{kind: decimalSum, decimal: v, decimalType: dtype.(arrow.DecimalType)}
+}
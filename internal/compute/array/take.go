@@ -0,0 +1,30 @@
+package array
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/compute"
+)
+
+// Take gathers the elements of the input array at the positions in the indices array, in the order of the indices.
+// The indices must be of an integer type; a null index gives a null element.
+// Returns an error if an index is negative or not less than the length of the array.
+func Take(ctx context.Context, arr arrow.Array, indices arrow.Array) (arrow.Array, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if !arrow.IsInteger(indices.DataType().ID()) {
+		return nil, fmt.Errorf("take indices must be integers: %s", indices.DataType())
+	}
+
+	// Arrow cannot take from dictionary arrays, so their codes are taken instead
+	if dictArr, ok := arr.(*array.Dictionary); ok {
+		return TakeDictionary(ctx, dictArr, indices)
+	}
+
+	return compute.TakeArray(ctx, arr, indices)
+}
@@ -0,0 +1,173 @@
+package array
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+
+	"github.com/SHIMA0111/gleam/internal/utils"
+)
+
+// DefaultLayout returns the Go time layout that parses and formats the strings of a temporal type
+// when no layout is given. Durations are always parsed with time.ParseDuration and formatted by time.Duration.
+func DefaultLayout(dtype arrow.DataType) string {
+	switch dtype.ID() {
+	case arrow.DATE32, arrow.DATE64:
+		return time.DateOnly
+	case arrow.TIME32, arrow.TIME64:
+		return "15:04:05.999999999"
+	default:
+		return time.RFC3339Nano
+	}
+}
+
+// IsTemporal reports whether the type is a date, timestamp, time of day or duration.
+func IsTemporal(dtype arrow.DataType) bool {
+	switch dtype.ID() {
+	case arrow.DATE32, arrow.DATE64, arrow.TIMESTAMP, arrow.TIME32, arrow.TIME64, arrow.DURATION:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseTemporal parses a string array into a Date32, Timestamp, Time64 or Duration array of the given type.
+// Dates, timestamps and times of day are parsed with the Go time layout, or DefaultLayout if it is empty;
+// a timestamp string without a zone is read in the zone of the type. Nulls stay null.
+func ParseTemporal(
+	ctx context.Context,
+	arr arrow.Array,
+	dtype arrow.DataType,
+	layout string,
+	mem memory.Allocator,
+) (arrow.Array, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	strArr, ok := arr.(utils.StringArray)
+	if !ok {
+		return nil, fmt.Errorf("cannot parse %s as %s", arr.DataType(), dtype)
+	}
+	if layout == "" {
+		layout = DefaultLayout(dtype)
+	}
+
+	var parse func(string) (int64, error)
+	switch dt := dtype.(type) {
+	case *arrow.Date32Type:
+		parse = func(v string) (int64, error) {
+			t, err := time.Parse(layout, v)
+			return int64(utils.Date32Of(t)), err
+		}
+	case *arrow.TimestampType:
+		loc, err := utils.Location(dt.TimeZone)
+		if err != nil {
+			return nil, err
+		}
+		parse = func(v string) (int64, error) {
+			t, err := time.ParseInLocation(layout, v, loc)
+			if err != nil {
+				return 0, err
+			}
+			ts, err := arrow.TimestampFromTime(t, dt.Unit)
+			return int64(ts), err
+		}
+	case *arrow.Time64Type:
+		parse = func(v string) (int64, error) {
+			t, err := time.Parse(layout, v)
+			return int64(utils.TimeOfDay(t) / dt.Unit.Multiplier()), err
+		}
+	case *arrow.DurationType:
+		parse = func(v string) (int64, error) {
+			d, err := time.ParseDuration(v)
+			return int64(d / dt.Unit.Multiplier()), err
+		}
+	default:
+		return nil, fmt.Errorf("cannot parse strings as %s", dtype)
+	}
+
+	builder := array.NewBuilder(mem, dtype)
+	defer builder.Release()
+	builder.Reserve(arr.Len())
+
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			builder.AppendNull()
+			continue
+		}
+
+		v, err := parse(strArr.Value(i))
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse %q as %s: %w", strArr.Value(i), dtype, err)
+		}
+
+		switch b := builder.(type) {
+		case *array.Date32Builder:
+			b.Append(arrow.Date32(v))
+		case *array.TimestampBuilder:
+			b.Append(arrow.Timestamp(v))
+		case *array.Time64Builder:
+			b.Append(arrow.Time64(v))
+		case *array.DurationBuilder:
+			b.Append(arrow.Duration(v))
+		}
+	}
+
+	return builder.NewArray(), nil
+}
+
+// FormatTemporal formats a temporal array as a String array with the Go time layout, or DefaultLayout if it is empty.
+// Timestamps are formatted in the zone of their type. Nulls stay null.
+func FormatTemporal(ctx context.Context, arr arrow.Array, layout string, mem memory.Allocator) (arrow.Array, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if layout == "" {
+		layout = DefaultLayout(arr.DataType())
+	}
+
+	var format func(i int) string
+	switch a := arr.(type) {
+	case *array.Date32:
+		format = func(i int) string { return a.Value(i).ToTime().Format(layout) }
+	case *array.Date64:
+		format = func(i int) string { return a.Value(i).ToTime().Format(layout) }
+	case *array.Timestamp:
+		dt := a.DataType().(*arrow.TimestampType)
+		loc, err := utils.Location(dt.TimeZone)
+		if err != nil {
+			return nil, err
+		}
+		format = func(i int) string { return a.Value(i).ToTime(dt.Unit).In(loc).Format(layout) }
+	case *array.Time32:
+		unit := a.DataType().(*arrow.Time32Type).Unit
+		format = func(i int) string { return a.Value(i).ToTime(unit).Format(layout) }
+	case *array.Time64:
+		unit := a.DataType().(*arrow.Time64Type).Unit
+		format = func(i int) string { return a.Value(i).ToTime(unit).Format(layout) }
+	case *array.Duration:
+		unit := a.DataType().(*arrow.DurationType).Unit
+		format = func(i int) string { return (time.Duration(a.Value(i)) * unit.Multiplier()).String() }
+	default:
+		return nil, fmt.Errorf("cannot format %s as strings", arr.DataType())
+	}
+
+	builder := array.NewStringBuilder(mem)
+	defer builder.Release()
+	builder.Reserve(arr.Len())
+
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			builder.AppendNull()
+			continue
+		}
+		builder.Append(format(i))
+	}
+
+	return builder.NewArray(), nil
+}
@@ -0,0 +1,58 @@
+package parallel
+
+import (
+	"context"
+	"runtime"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// MapRanges splits the positions [0, length) into ranges of chunkSize positions and maps every range
+// to a partial result concurrently, returning the partial results in range order. Unlike Reduce, the mapper
+// sees the positions of its range, for results that refer to positions in the whole array.
+// A chunkSize of 0 maps all the positions as one range.
+func MapRanges[P any](
+	ctx context.Context,
+	length int,
+	chunkSize int,
+	mapper func(ctx context.Context, start, end int) (P, error),
+) ([]P, error) {
+	if chunkSize <= 0 || length <= chunkSize {
+		partial, err := mapper(ctx, 0, length)
+		if err != nil {
+			return nil, err
+		}
+		return []P{partial}, nil
+	}
+
+	numChunks := (length + chunkSize - 1) / chunkSize
+	partials := make([]P, numChunks)
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(runtime.NumCPU())
+
+	for i := 0; i < numChunks; i++ {
+		start := i * chunkSize
+		end := min(start+chunkSize, length)
+
+		group.Go(func() error {
+			if err := groupCtx.Err(); err != nil {
+				return err
+			}
+
+			partial, err := mapper(groupCtx, start, end)
+			if err != nil {
+				return err
+			}
+			partials[i] = partial
+
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+
+	return partials, nil
+}
@@ -0,0 +1,73 @@
+package parallel
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"golang.org/x/sync/errgroup"
+)
+
+// ChunkSize returns the chunk size that splits an array of the given length into one chunk per CPU.
+func ChunkSize(length int) int {
+	// Go non-float number division works as a truncation float point so add 1
+	return length/runtime.NumCPU() + 1
+}
+
+// Reduce splits the array into zero-copy chunks of chunkSize elements, maps every chunk to a partial result
+// concurrently and merges the partial results in chunk order, so the merge order does not depend on scheduling.
+// The first error returned by a chunk is returned by Reduce and cancels the context passed to the other chunks.
+func Reduce[P any](
+	ctx context.Context,
+	arr arrow.Array,
+	chunkSize int,
+	mapper func(context.Context, arrow.Array) (P, error),
+	merge func(P, P) P,
+) (P, error) {
+	length := arr.Len()
+	if chunkSize <= 0 || length <= chunkSize {
+		return mapper(ctx, arr)
+	}
+
+	numChunks := (length + chunkSize - 1) / chunkSize
+	partials := make([]P, numChunks)
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(runtime.NumCPU())
+
+	for i := 0; i < numChunks; i++ {
+		start := i * chunkSize
+		end := min(start+chunkSize, length)
+
+		group.Go(func() error {
+			// Skip the remaining chunks once a sibling failed or the caller cancelled
+			if err := groupCtx.Err(); err != nil {
+				return err
+			}
+
+			view := array.NewSlice(arr, int64(start), int64(end))
+			defer view.Release()
+
+			partial, err := mapper(groupCtx, view)
+			if err != nil {
+				return err
+			}
+			partials[i] = partial
+
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		var zero P
+		return zero, err
+	}
+
+	result := partials[0]
+	for _, partial := range partials[1:] {
+		result = merge(result, partial)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,92 @@
+package parallel
+
+import (
+	"context"
+	"runtime"
+	"slices"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// SortFunc sorts the items by cmp, splitting them into runs of chunkSize items that are sorted concurrently
+// and then merged pairwise. The merges keep the items of an earlier run first on ties, so the sort is stable
+// if stable is true, which makes the runs sorted stably as well. A chunkSize of 0 sorts the items in one piece.
+func SortFunc[E any](ctx context.Context, items []E, chunkSize int, cmp func(a, b E) int, stable bool) error {
+	sortRun := slices.SortFunc[[]E, E]
+	if stable {
+		sortRun = slices.SortStableFunc[[]E, E]
+	}
+
+	length := len(items)
+	if chunkSize <= 0 || length <= chunkSize {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		sortRun(items, cmp)
+		return nil
+	}
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(runtime.NumCPU())
+
+	for start := 0; start < length; start += chunkSize {
+		run := items[start:min(start+chunkSize, length)]
+		group.Go(func() error {
+			if err := groupCtx.Err(); err != nil {
+				return err
+			}
+			sortRun(run, cmp)
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return err
+	}
+
+	// Every round merges neighbouring sorted runs into runs twice as long, between the two buffers
+	src, dst := items, make([]E, length)
+	for width := chunkSize; width < length; width *= 2 {
+		group, groupCtx := errgroup.WithContext(ctx)
+		group.SetLimit(runtime.NumCPU())
+
+		for start := 0; start < length; start += 2 * width {
+			mid, end := min(start+width, length), min(start+2*width, length)
+			group.Go(func() error {
+				if err := groupCtx.Err(); err != nil {
+					return err
+				}
+				merge(dst[start:end], src[start:mid], src[mid:end], cmp)
+				return nil
+			})
+		}
+
+		if err := group.Wait(); err != nil {
+			return err
+		}
+		src, dst = dst, src
+	}
+
+	if &src[0] != &items[0] {
+		copy(items, src)
+	}
+
+	return nil
+}
+
+// merge merges the sorted left and right into dst, taking from left on ties.
+func merge[E any](dst, left, right []E, cmp func(a, b E) int) {
+	i, j, k := 0, 0, 0
+	for i < len(left) && j < len(right) {
+		if cmp(right[j], left[i]) < 0 {
+			dst[k] = right[j]
+			j++
+		} else {
+			dst[k] = left[i]
+			i++
+		}
+		k++
+	}
+	k += copy(dst[k:], left[i:])
+	copy(dst[k:], right[j:])
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/bitutil"
+)
+
+// VisitValidRuns calls visit with every run [start, end) of consecutive valid (non-null) elements of the array.
+// The indices are relative to the array, so they can index the slice returned by arrow.GetValues directly.
+// The validity bitmap is read a 64-bit word at a time where possible: all-valid words extend the current run
+// and all-null words are skipped without looking at the single bits, so no filtered copy of the data is needed.
+func VisitValidRuns(arr arrow.Array, visit func(start, end int)) {
+	length := arr.Len()
+	nullCount := arr.NullN()
+	if nullCount == 0 {
+		if length > 0 {
+			visit(0, length)
+		}
+		return
+	}
+	if nullCount == length {
+		return
+	}
+
+	bitmap := arr.NullBitmapBytes()
+	offset := arr.Data().Offset()
+
+	runStart := -1
+	flush := func(i int) {
+		if runStart >= 0 {
+			visit(runStart, i)
+			runStart = -1
+		}
+	}
+
+	for i := 0; i < length; {
+		pos := offset + i
+		if pos%8 == 0 && i+64 <= length {
+			word := binary.LittleEndian.Uint64(bitmap[pos/8:])
+			switch word {
+			case math.MaxUint64:
+				if runStart < 0 {
+					runStart = i
+				}
+			case 0:
+				flush(i)
+			default:
+				// Jump from run to run inside the word by counting the equal trailing bits
+				for bit := 0; bit < 64; {
+					rest := word >> bit
+					if rest&1 == 1 {
+						if runStart < 0 {
+							runStart = i + bit
+						}
+						bit += bits.TrailingZeros64(^rest)
+					} else {
+						flush(i + bit)
+						bit += bits.TrailingZeros64(rest)
+					}
+				}
+			}
+			i += 64
+			continue
+		}
+
+		if bitutil.BitIsSet(bitmap, pos) {
+			if runStart < 0 {
+				runStart = i
+			}
+		} else {
+			flush(i)
+		}
+		i++
+	}
+	flush(length)
+}
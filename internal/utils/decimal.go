@@ -0,0 +1,99 @@
+package utils
+
+import (
+	"math/big"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/decimal128"
+	"github.com/apache/arrow-go/v18/arrow/decimal256"
+
+	"github.com/SHIMA0111/gleam/gleam/utils"
+)
+
+// Pow10 returns 10^n as a big integer.
+func Pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// RoundRat returns r scaled by 10^scale and rounded to an integer with the rounding mode,
+// i.e. the unscaled value of r as a decimal with the given scale.
+func RoundRat(r *big.Rat, scale int32, mode utils.RoundingMode) *big.Int {
+	num := new(big.Int).Set(r.Num())
+	den := new(big.Int).Set(r.Denom())
+	if scale >= 0 {
+		num.Mul(num, Pow10(scale))
+	} else {
+		den.Mul(den, Pow10(-scale))
+	}
+
+	// QuoRem truncates towards zero, so the remainder has the sign of r
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() == 0 {
+		return quo
+	}
+
+	sign := r.Sign()
+	// half compares the dropped fraction with one half: -1 below, 0 a tie, +1 above
+	half := new(big.Int).Abs(rem)
+	half.Lsh(half, 1)
+	cmpHalf := half.Cmp(den)
+
+	var away bool
+	switch mode {
+	case utils.RoundHalfUp:
+		away = cmpHalf >= 0
+	case utils.RoundHalfDown:
+		away = cmpHalf > 0
+	case utils.RoundDown:
+		away = false
+	case utils.RoundUp:
+		away = true
+	case utils.RoundFloor:
+		away = sign < 0
+	case utils.RoundCeiling:
+		away = sign > 0
+	default:
+		away = cmpHalf > 0 || (cmpHalf == 0 && quo.Bit(0) == 1)
+	}
+
+	if away {
+		quo.Add(quo, big.NewInt(int64(sign)))
+	}
+
+	return quo
+}
+
+// FitsInPrecision reports whether the unscaled decimal value has at most precision digits.
+func FitsInPrecision(v *big.Int, precision int32) bool {
+	return new(big.Int).Abs(v).Cmp(Pow10(precision)) < 0
+}
+
+// SumDecimal128Valid returns the exact unscaled sum of the valid values of a Decimal128 array.
+// The sum is accumulated in 256 bits, which cannot overflow for any array that fits in memory.
+func SumDecimal128Valid(arr arrow.Array) *big.Int {
+	values := arrow.GetValues[decimal128.Num](arr.Data(), 1)
+
+	var sum decimal256.Num
+	VisitValidRuns(arr, func(start, end int) {
+		for _, v := range values[start:end] {
+			sum = sum.Add(decimal256.FromDecimal128(v))
+		}
+	})
+
+	return sum.BigInt()
+}
+
+// SumDecimal256Valid returns the exact unscaled sum of the valid values of a Decimal256 array.
+// 256 bits hold only a few 76-digit values, so the sum is accumulated as a big integer.
+func SumDecimal256Valid(arr arrow.Array) *big.Int {
+	values := arrow.GetValues[decimal256.Num](arr.Data(), 1)
+
+	sum := new(big.Int)
+	VisitValidRuns(arr, func(start, end int) {
+		for _, v := range values[start:end] {
+			sum.Add(sum, v.BigInt())
+		}
+	})
+
+	return sum
+}
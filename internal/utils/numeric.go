@@ -8,7 +8,21 @@ type Numeric interface {
 		~float32 | ~float64
 }
 
+type Signed interface {
+	~int8 | ~int16 | ~int32 | ~int64
+}
+
+type Unsigned interface {
+	~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
 type NumericArray[T Numeric] interface {
 	arrow.Array
 	Value(i int) T
 }
+
+// StringArray is an arrow array of strings, with either 32-bit or 64-bit offsets.
+type StringArray interface {
+	arrow.Array
+	Value(i int) string
+}
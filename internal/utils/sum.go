@@ -4,30 +4,223 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"math/bits"
 
 	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
 	"github.com/apache/arrow-go/v18/arrow/compute"
+	"github.com/apache/arrow-go/v18/arrow/decimal128"
 	arrayMath "github.com/apache/arrow-go/v18/arrow/math"
 )
 
-type Number64 interface {
-	~int64 | ~uint64 | ~float64
+// signedSum accumulates an exact signed integer sum.
+// The running total is kept in an int64 and is moved into the 128-bit accumulator only when
+// the next addition would overflow, so the common case stays a plain add loop.
+type signedSum struct {
+	wide decimal128.Num
+	acc  int64
 }
 
-func CheckOverflowAndConvertToFloat64[T Number64](v T) (float64, error) {
-	floatingValue := float64(v)
-	if math.IsNaN(floatingValue) || math.IsInf(floatingValue, 0) {
-		return 0, fmt.Errorf("infinity or nan float detected: %v", v)
+func addSigned[T Signed](s *signedSum, values []T) {
+	acc := s.acc
+	for _, value := range values {
+		v := int64(value)
+		next := acc + v
+		// The addition overflowed only if both operands have the same sign and the result does not.
+		if (acc^next)&(v^next) < 0 {
+			s.wide = s.wide.Add(decimal128.FromI64(acc))
+			next = v
+		}
+		acc = next
 	}
+	s.acc = acc
+}
+
+func (s *signedSum) total() decimal128.Num {
+	return s.wide.Add(decimal128.FromI64(s.acc))
+}
+
+// unsignedSum accumulates an exact unsigned integer sum. The carry out of the low 64 bits is counted in hi.
+type unsignedSum struct {
+	hi, lo uint64
+}
 
-	// Float64's significant digits are 2^53 (-2^53), and 2^53 is enough in the almost case in the real world.
-	// In data analytics, if the data is over this value, you may do normalize/standardize the data.
-	if 1<<53 <= math.Abs(floatingValue) {
-		return floatingValue, fmt.Errorf("overflow detected over 53 digits: %d", uint64(v))
+func addUnsigned[T Unsigned](s *unsignedSum, values []T) {
+	hi, lo := s.hi, s.lo
+	var carry uint64
+	for _, value := range values {
+		lo, carry = bits.Add64(lo, uint64(value), 0)
+		hi += carry
 	}
+	s.hi, s.lo = hi, lo
+}
 
-	return floatingValue, nil
+func (s *unsignedSum) total() decimal128.Num {
+	return decimal128.New(int64(s.hi), s.lo)
+}
+
+// SumSigned returns the exact sum of the signed integer values as a 128-bit integer.
+func SumSigned[T Signed](values []T) decimal128.Num {
+	var sum signedSum
+	addSigned(&sum, values)
+
+	return sum.total()
+}
+
+// SumUnsigned returns the exact sum of the unsigned integer values as a 128-bit integer.
+func SumUnsigned[T Unsigned](values []T) decimal128.Num {
+	var sum unsignedSum
+	addUnsigned(&sum, values)
+
+	return sum.total()
+}
+
+// SumSignedValid returns the exact sum of the valid values of a nullable signed integer array.
+func SumSignedValid[T Signed](arr arrow.Array) decimal128.Num {
+	values := arrow.GetValues[T](arr.Data(), 1)
+
+	var sum signedSum
+	VisitValidRuns(arr, func(start, end int) {
+		addSigned(&sum, values[start:end])
+	})
+
+	return sum.total()
+}
+
+// SumUnsignedValid returns the exact sum of the valid values of a nullable unsigned integer array.
+func SumUnsignedValid[T Unsigned](arr arrow.Array) decimal128.Num {
+	values := arrow.GetValues[T](arr.Data(), 1)
+
+	var sum unsignedSum
+	VisitValidRuns(arr, func(start, end int) {
+		addUnsigned(&sum, values[start:end])
+	})
+
+	return sum.total()
+}
+
+// SumFloatValid returns the sum of the valid values of a nullable float array.
+func SumFloatValid[T float32 | float64](arr arrow.Array) float64 {
+	values := arrow.GetValues[T](arr.Data(), 1)
+
+	var sum float64
+	VisitValidRuns(arr, func(start, end int) {
+		for _, v := range values[start:end] {
+			sum += float64(v)
+		}
+	})
+
+	return sum
+}
+
+// KahanSum is a float sum with its Kahan-Babuska (Neumaier) compensation term.
+// The compensation collects the low-order bits lost by every addition, so Value is accurate to
+// the last bit for any realistic input, independent of the magnitudes being added.
+type KahanSum struct {
+	Sum  float64
+	Comp float64
+}
+
+// Add adds a single value to the compensated sum.
+func (k *KahanSum) Add(v float64) {
+	t := k.Sum + v
+	if math.Abs(k.Sum) >= math.Abs(v) {
+		k.Comp += (k.Sum - t) + v
+	} else {
+		k.Comp += (v - t) + k.Sum
+	}
+	k.Sum = t
+}
+
+// Merge combines two compensated sums.
+func (k KahanSum) Merge(other KahanSum) KahanSum {
+	merged := KahanSum{Sum: k.Sum, Comp: k.Comp + other.Comp}
+	merged.Add(other.Sum)
+
+	return merged
+}
+
+// Value returns the compensated sum.
+func (k KahanSum) Value() float64 {
+	// Once the sum is infinite the compensation is meaningless (it becomes NaN from Inf - Inf)
+	if math.IsInf(k.Sum, 0) {
+		return k.Sum
+	}
+
+	return k.Sum + k.Comp
+}
+
+// SumFloatPrecise returns the compensated sum of the valid values of a float array.
+func SumFloatPrecise[T float32 | float64](arr arrow.Array) KahanSum {
+	values := arrow.GetValues[T](arr.Data(), 1)
+
+	var sum KahanSum
+	VisitValidRuns(arr, func(start, end int) {
+		for _, v := range values[start:end] {
+			sum.Add(float64(v))
+		}
+	})
+
+	return sum
+}
+
+// SumFloatSkipNaN returns the sum of the valid values of a float array that are not NaN,
+// and the number of NaN values that were left out.
+func SumFloatSkipNaN[T float32 | float64](arr arrow.Array) (float64, int64) {
+	values := arrow.GetValues[T](arr.Data(), 1)
+
+	var sum float64
+	var nanCount int64
+	VisitValidRuns(arr, func(start, end int) {
+		for _, v := range values[start:end] {
+			if v != v {
+				nanCount++
+				continue
+			}
+			sum += float64(v)
+		}
+	})
+
+	return sum, nanCount
+}
+
+// SumFloatPreciseSkipNaN is SumFloatSkipNaN with the compensated sum of SumFloatPrecise.
+func SumFloatPreciseSkipNaN[T float32 | float64](arr arrow.Array) (KahanSum, int64) {
+	values := arrow.GetValues[T](arr.Data(), 1)
+
+	var sum KahanSum
+	var nanCount int64
+	VisitValidRuns(arr, func(start, end int) {
+		for _, v := range values[start:end] {
+			if v != v {
+				nanCount++
+				continue
+			}
+			sum.Add(float64(v))
+		}
+	})
+
+	return sum, nanCount
+}
+
+// HasNaN reports whether any valid value of a float array is NaN.
+func HasNaN[T float32 | float64](arr arrow.Array) bool {
+	values := arrow.GetValues[T](arr.Data(), 1)
+
+	found := false
+	VisitValidRuns(arr, func(start, end int) {
+		if found {
+			return
+		}
+		for _, v := range values[start:end] {
+			if v != v {
+				found = true
+				return
+			}
+		}
+	})
+
+	return found
 }
 
 func SumInt8Array(arr *array.Int8) int64 {
@@ -92,7 +285,10 @@ func SumFloat32Array(arr *array.Float32) float64 {
 	return sum
 }
 
-func CastSumInt(ctx context.Context, arr arrow.Array) (float64, error) {
+// CastSumInt sums a narrow signed integer array (int8, int16, int32) by casting it to Int64.
+// The int64 accumulator cannot overflow for these inputs until the array has 2^32 elements,
+// so the assembly-optimized arrow sum is used without an overflow check.
+func CastSumInt(ctx context.Context, arr arrow.Array) (int64, error) {
 	op := compute.NewCastOptions(arrow.PrimitiveTypes.Int64, true)
 	castedArray, err := compute.CastArray(ctx, arr, op)
 
@@ -106,17 +302,13 @@ func CastSumInt(ctx context.Context, arr arrow.Array) (float64, error) {
 	if !ok {
 		return 0, fmt.Errorf("failed to cast the array to Int64 from %s: %w", arr.DataType(), err)
 	}
-	sumIntValue := arrayMath.Int64.Sum(i64Array)
 
-	// To abstraction, all sum functions return float64, but float64's significant digits are 53 digits
-	// 53 digits are enough in the real world because the value is a cornucopia
-	if 1<<53 <= sumIntValue {
-		return 0, fmt.Errorf("overflow: %d", sumIntValue)
-	}
-	return CheckOverflowAndConvertToFloat64[int64](sumIntValue)
+	return arrayMath.Int64.Sum(i64Array), nil
 }
 
-func CastSumUInt(ctx context.Context, arr arrow.Array) (float64, error) {
+// CastSumUInt sums a narrow unsigned integer array (uint8, uint16, uint32) by casting it to Uint64.
+// Like CastSumInt, the uint64 accumulator cannot overflow for these inputs.
+func CastSumUInt(ctx context.Context, arr arrow.Array) (uint64, error) {
 	// Cast the data to UInt64
 	castedArray, err := compute.CastToType(ctx, arr, arrow.PrimitiveTypes.Uint64)
 	if err != nil {
@@ -129,9 +321,8 @@ func CastSumUInt(ctx context.Context, arr arrow.Array) (float64, error) {
 	if !ok {
 		return 0, fmt.Errorf("failed to cast the array to Uint64 from %s: %w", arr.DataType(), err)
 	}
-	sumUIntValue := arrayMath.Uint64.Sum(u64Array)
 
-	return CheckOverflowAndConvertToFloat64[uint64](sumUIntValue)
+	return arrayMath.Uint64.Sum(u64Array), nil
 }
 
 func CastSumFloat(ctx context.Context, arr arrow.Array) (float64, error) {
@@ -142,7 +333,6 @@ func CastSumFloat(ctx context.Context, arr arrow.Array) (float64, error) {
 	defer castedArray.Release()
 
 	f64Array := castedArray.(*array.Float64)
-	sumFloatValue := arrayMath.Float64.Sum(f64Array)
 
-	return CheckOverflowAndConvertToFloat64[float64](sumFloatValue)
+	return arrayMath.Float64.Sum(f64Array), nil
 }
@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+)
+
+// Date32Of returns the calendar date of t in its own location as a Date32,
+// unlike arrow.Date32FromTime, which truncates the instant in UTC.
+func Date32Of(t time.Time) arrow.Date32 {
+	year, month, day := t.Date()
+	return arrow.Date32FromTime(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// TimeOfDay returns the time elapsed since midnight of t in its own location.
+func TimeOfDay(t time.Time) time.Duration {
+	hour, minute, sec := t.Clock()
+	return time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute +
+		time.Duration(sec)*time.Second + time.Duration(t.Nanosecond())
+}
+
+// Location returns the location of an arrow time zone name. An empty name is UTC.
+func Location(zone string) (*time.Location, error) {
+	if zone == "" {
+		return time.UTC, nil
+	}
+
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %q: %w", zone, err)
+	}
+
+	return loc, nil
+}